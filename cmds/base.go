@@ -171,13 +171,17 @@ func AttachProposalProcessor(
 		return nil, err
 	} else if _, err := opr.SetProcessor(collection.CollectionPolicyUpdaterHinter, collection.NewCollectionPolicyUpdaterProcessor(cp)); err != nil {
 		return nil, err
-	} else if _, err := opr.SetProcessor(collection.MintHinter, collection.NewMintProcessor(cp)); err != nil {
+	} else if _, err := opr.SetProcessor(collection.MintHinter, collection.NewMintProcessor(cp, policy.NetworkID())); err != nil {
 		return nil, err
 	} else if _, err := opr.SetProcessor(collection.TransferHinter, collection.NewTransferProcessor(cp)); err != nil {
 		return nil, err
 	} else if _, err := opr.SetProcessor(collection.BurnHinter, collection.NewBurnProcessor(cp)); err != nil {
 		return nil, err
-	} else if _, err := opr.SetProcessor(collection.SignHinter, collection.NewSignProcessor(cp)); err != nil {
+	} else if _, err := opr.SetProcessor(collection.SignHinter, collection.NewSignProcessor(cp, policy.NetworkID())); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(collection.ReplaceSignerHinter, collection.NewReplaceSignerProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(collection.TransferShareHinter, collection.NewTransferShareProcessor(cp)); err != nil {
 		return nil, err
 	}
 
@@ -248,6 +252,8 @@ func InitializeProposalProcessor(ctx context.Context, opr *collection.OperationP
 		collection.TransferHinter,
 		collection.BurnHinter,
 		collection.SignHinter,
+		collection.ReplaceSignerHinter,
+		collection.TransferShareHinter,
 	} {
 		if err := oprs.Add(hinter, opr); err != nil {
 			return ctx, err
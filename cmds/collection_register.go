@@ -21,17 +21,22 @@ import (
 type CollectionRegisterCommand struct {
 	*BaseCommand
 	OperationFlags
-	Sender   AddressFlag                 `arg:"" name:"sender" help:"sender address" required:"true"`
-	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
-	Target   AddressFlag                 `arg:"" name:"target" help:"target account to register policy" required:"true"`
-	CSymbol  string                      `arg:"" name:"symbol" help:"collection symbol" required:"true"`
-	Name     string                      `arg:"" name:"name" help:"collection name" required:"true"`
-	Royalty  uint                        `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
-	Uri      string                      `name:"uri" help:"collection uri" optional:""`
-	White    AddressFlag                 `name:"white" help:"whitelisted address" optional:""`
-	sender   base.Address
-	target   base.Address
-	form     collection.CollectionRegisterForm
+	Sender     AddressFlag                 `arg:"" name:"sender" help:"sender address" required:"true"`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Target     AddressFlag                 `arg:"" name:"target" help:"target account to register policy" required:"true"`
+	CSymbol    string                      `arg:"" name:"symbol" help:"collection symbol" required:"true"`
+	Name       string                      `arg:"" name:"name" help:"collection name" required:"true"`
+	Royalty    uint                        `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
+	Uri        string                      `name:"uri" help:"collection uri" optional:""`
+	White      AddressFlag                 `name:"white" help:"whitelisted address" optional:""`
+	HashAlgo   string                      `name:"hash-algorithm" help:"required nft hash algorithm (sha256, multihash, keccak256)" optional:""`
+	Schemes    []string                    `name:"scheme" help:"allowed nft uri scheme; ipfs, ar, https, ..." optional:""`
+	MinterIdx  bool                        `name:"minter-idx" help:"minters choose nft idx instead of collection counter" optional:""`
+	Limit      uint64                      `name:"limit" help:"max nft idx of collection; 0 for default" optional:""`
+	Uniqueness string                      `name:"hash-uniqueness" help:"scope in which nft hash must be unique (collection, global)" optional:""`
+	sender     base.Address
+	target     base.Address
+	form       collection.CollectionRegisterForm
 }
 
 func NewCollectionRegisterCommand() CollectionRegisterCommand {
@@ -118,7 +123,19 @@ func (cmd *CollectionRegisterCommand) parseFlags() error {
 		whites = append(whites, white)
 	}
 
-	form := collection.NewCollectionRegisterForm(cmd.target, symbol, name, royalty, uri, whites)
+	hashAlgorithm := nft.HashAlgorithm(cmd.HashAlgo)
+	if len(hashAlgorithm) > 0 {
+		if err := hashAlgorithm.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	schemes := make([]nft.URIScheme, len(cmd.Schemes))
+	for i := range cmd.Schemes {
+		schemes[i] = nft.URIScheme(cmd.Schemes[i])
+	}
+
+	form := collection.NewCollectionRegisterForm(cmd.target, symbol, name, royalty, uri, whites, hashAlgorithm, schemes, cmd.MinterIdx, cmd.Limit, collection.HashUniqueness(cmd.Uniqueness))
 	if err := form.IsValid(nil); err != nil {
 		return err
 	}
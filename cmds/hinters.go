@@ -59,12 +59,15 @@ var types = []hint.Type{
 	extensioncurrency.WithdrawsItemMultiAmountsType,
 	extensioncurrency.WithdrawsItemSingleAmountType,
 	nft.NFTIDType,
+	nft.NFTAddressType,
 	nft.NFTType,
 	nft.DesignType,
 	nft.SignerType,
 	nft.SignersType,
 	collection.NFTBoxType,
 	collection.AgentBoxType,
+	collection.SignerReplacementType,
+	collection.SignerReplacementBoxType,
 	collection.CollectionPolicyType,
 	collection.MintFormType,
 	collection.DelegateFactType,
@@ -90,12 +93,23 @@ var types = []hint.Type{
 	collection.SignItemType,
 	collection.SignFactType,
 	collection.SignType,
+	collection.ReplaceSignerItemType,
+	collection.ReplaceSignerFactType,
+	collection.ReplaceSignerType,
+	collection.TransferShareItemType,
+	collection.TransferShareFactType,
+	collection.TransferShareType,
 	digest.ProblemType,
 	digest.NodeInfoType,
 	digest.BaseHalType,
 	digest.AccountValueType,
 	digest.OperationValueType,
 	digest.NFTValueType,
+	digest.NFTHistoryValueType,
+	digest.NFTDelegatorValueType,
+	digest.NFTCollectionStatsValueType,
+	digest.NFTHolderValueType,
+	digest.SimulationValueType,
 }
 
 var hinters = []hint.Hinter{
@@ -140,12 +154,15 @@ var hinters = []hint.Hinter{
 	extensioncurrency.WithdrawsItemMultiAmountsHinter,
 	extensioncurrency.WithdrawsItemSingleAmountHinter,
 	nft.NFTIDHinter,
+	nft.NFTAddressHinter,
 	nft.NFTHinter,
 	nft.DesignHinter,
 	nft.SignerHinter,
 	nft.SignersHinter,
 	collection.NFTBoxHinter,
 	collection.AgentBoxHinter,
+	collection.SignerReplacementHinter,
+	collection.SignerReplacementBoxHinter,
 	collection.CollectionPolicyHinter,
 	collection.MintFormHinter,
 	collection.DelegateFactHinter,
@@ -171,11 +188,22 @@ var hinters = []hint.Hinter{
 	collection.SignItemHinter,
 	collection.SignFactHinter,
 	collection.SignHinter,
+	collection.ReplaceSignerItemHinter,
+	collection.ReplaceSignerFactHinter,
+	collection.ReplaceSignerHinter,
+	collection.TransferShareItemHinter,
+	collection.TransferShareFactHinter,
+	collection.TransferShareHinter,
 	digest.AccountValue{},
 	digest.BaseHal{},
 	digest.NodeInfo{},
 	digest.OperationValue{},
 	digest.NFTValue{},
+	digest.NFTHistoryValue{},
+	digest.NFTDelegatorValue{},
+	digest.NFTCollectionStatsValue{},
+	digest.NFTHolderValue{},
+	digest.SimulationValue{},
 	digest.Problem{},
 }
 
@@ -16,17 +16,23 @@ import (
 type MintCommand struct {
 	*BaseCommand
 	OperationFlags
-	Sender           AddressFlag                 `arg:"" name:"sender" help:"sender address" required:"true"`
-	Currency         currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
-	CSymbol          string                      `arg:"" name:"collection" help:"collection symbol" required:"true"`
-	Hash             string                      `arg:"" name:"hash" help:"nft hash" required:"true"`
-	Uri              string                      `arg:"" name:"uri" help:"nft uri" required:"true"`
-	Creator          SignerFlag                  `name:"creator" help:"nft contents creator \"<address>,<share>\"" optional:""`
-	Copyrighter      SignerFlag                  `name:"copyrighter" help:"nft contents copyrighter \"<address>,<share>\"" optional:""`
-	CreatorTotal     uint                        `name:"creator-total" help:"creators total share" optional:""`
-	CopyrighterTotal uint                        `name:"copyrighter-total" help:"copyrighters total share" optional:""`
-	sender           base.Address
-	form             collection.MintForm
+	Sender            AddressFlag                 `arg:"" name:"sender" help:"sender address" required:"true"`
+	Currency          currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	CSymbol           string                      `arg:"" name:"collection" help:"collection symbol" required:"true"`
+	Hash              string                      `arg:"" name:"hash" help:"nft hash" required:"true"`
+	Uri               string                      `arg:"" name:"uri" help:"nft uri; empty for collection uri template" optional:""`
+	Creator           SignerFlag                  `name:"creator" help:"nft contents creator \"<address>,<share>\"" optional:""`
+	Copyrighter       SignerFlag                  `name:"copyrighter" help:"nft contents copyrighter \"<address>,<share>\"" optional:""`
+	CreatorTotal      uint                        `name:"creator-total" help:"creators total share" optional:""`
+	CopyrighterTotal  uint                        `name:"copyrighter-total" help:"copyrighters total share" optional:""`
+	CreatorSigned     bool                        `name:"creator-signed" help:"creator signs at minting with privatekey; fact sign of creator is needed" optional:""`
+	CopyrighterSigned bool                        `name:"copyrighter-signed" help:"copyrighter signs at minting with privatekey; fact sign of copyrighter is needed" optional:""`
+	Receivers         []AddressFlag               `name:"receiver" help:"nft receiver address; one nft is minted for each receiver" optional:""`
+	Idx               uint64                      `name:"idx" help:"expected nft idx to attest; needed to sign at minting" optional:""`
+	AttestURI         string                      `name:"attest-uri" help:"resolved nft uri to attest; needed to sign at minting with collection uri template" optional:""`
+	sender            base.Address
+	receivers         []base.Address
+	form              collection.MintForm
 }
 
 func NewMintCommand() MintCommand {
@@ -73,6 +79,14 @@ func (cmd *MintCommand) parseFlags() error {
 		cmd.sender = a
 	}
 
+	for i := range cmd.Receivers {
+		a, err := cmd.Receivers[i].Encode(jenc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid receiver format; %q", cmd.Receivers[i].String())
+		}
+		cmd.receivers = append(cmd.receivers, a)
+	}
+
 	hash := nft.NFTHash(cmd.Hash)
 	if err := hash.IsValid(nil); err != nil {
 		return err
@@ -83,12 +97,29 @@ func (cmd *MintCommand) parseFlags() error {
 		return err
 	}
 
+	if cmd.CreatorSigned || cmd.CopyrighterSigned {
+		if cmd.Idx < 1 {
+			return errors.Errorf("empty nft idx to attest")
+		}
+
+		if len(cmd.AttestURI) > 0 {
+			uri = nft.URI(cmd.AttestURI)
+		}
+
+		if len(uri) < 1 {
+			return errors.Errorf("empty nft uri to attest")
+		}
+	}
+
 	var crts = []nft.Signer{}
 	if len(cmd.Creator.address) > 0 {
 		if a, err := cmd.Creator.Encode(jenc); err != nil {
 			return errors.Wrapf(err, "invalid creator format; %q", cmd.Creator)
 		} else {
-			signer := nft.NewSigner(a, cmd.Creator.share, false)
+			signer, err := cmd.newSigner(a, cmd.Creator.share, cmd.CreatorSigned, hash, uri)
+			if err != nil {
+				return err
+			}
 			if err = signer.IsValid(nil); err != nil {
 				return err
 			}
@@ -101,7 +132,10 @@ func (cmd *MintCommand) parseFlags() error {
 		if a, err := cmd.Copyrighter.Encode(jenc); err != nil {
 			return errors.Wrapf(err, "invalid copyrighter format; %q", cmd.Copyrighter)
 		} else {
-			signer := nft.NewSigner(a, cmd.Copyrighter.share, false)
+			signer, err := cmd.newSigner(a, cmd.Copyrighter.share, cmd.CopyrighterSigned, hash, uri)
+			if err != nil {
+				return err
+			}
 			if err = signer.IsValid(nil); err != nil {
 				return err
 			}
@@ -129,8 +163,23 @@ func (cmd *MintCommand) parseFlags() error {
 
 }
 
+func (cmd *MintCommand) newSigner(a base.Address, share uint, signed bool, hash nft.NFTHash, uri nft.URI) (nft.Signer, error) {
+	if !signed {
+		return nft.NewSigner(a, share, false, nil, nil), nil
+	}
+
+	id := nft.NewNFTID(extensioncurrency.ContractID(cmd.CSymbol), cmd.Idx)
+
+	sig, err := cmd.Privatekey.Sign(nft.AttestationBody(cmd.NetworkID.NetworkID(), id, hash, uri))
+	if err != nil {
+		return nft.Signer{}, errors.Wrap(err, "failed to sign attestation")
+	}
+
+	return nft.NewSigner(a, share, true, cmd.Privatekey.Publickey(), sig), nil
+}
+
 func (cmd *MintCommand) createOperation() (operation.Operation, error) {
-	item := collection.NewMintItem(extensioncurrency.ContractID(cmd.CSymbol), cmd.form, cmd.Currency.CID)
+	item := collection.NewMintItem(extensioncurrency.ContractID(cmd.CSymbol), cmd.form, cmd.receivers, nil, cmd.Currency.CID)
 	fact := collection.NewMintFact([]byte(cmd.Token), cmd.sender, []collection.MintItem{item})
 
 	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
@@ -0,0 +1,131 @@
+package cmds
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+)
+
+type ReplaceSignerCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender        AddressFlag                 `arg:"" name:"sender" help:"sender address; nft owner" required:"true"`
+	Currency      currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	NFT           NFTIDFlag                   `arg:"" name:"nft" help:"target nft; \"<symbol>,<idx>\""`
+	Old           AddressFlag                 `arg:"" name:"old" help:"signer to be replaced" required:"true"`
+	New           AddressFlag                 `arg:"" name:"new" help:"new signer" required:"true"`
+	Qualification string                      `name:"qualification" help:"target qualification; creator | copyrighter" optional:""`
+	sender        base.Address
+	nft           nft.NFTID
+	old           base.Address
+	new           base.Address
+	qualification collection.Qualification
+}
+
+func NewReplaceSignerCommand() ReplaceSignerCommand {
+	return ReplaceSignerCommand{
+		BaseCommand: NewBaseCommand("replace-signer-operation"),
+	}
+}
+
+func (cmd *ReplaceSignerCommand) Run(version util.Version) error {
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *ReplaceSignerCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid sender format; %q", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Old.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid old signer format; %q", cmd.Old)
+	} else {
+		cmd.old = a
+	}
+
+	if a, err := cmd.New.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid new signer format; %q", cmd.New)
+	} else {
+		cmd.new = a
+	}
+
+	n := nft.NewNFTID(cmd.NFT.collection, cmd.NFT.idx)
+	if err := n.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.nft = n
+
+	if cmd.Qualification == "" {
+		cmd.qualification = collection.CreatorQualification
+	} else {
+		q := collection.Qualification(cmd.Qualification)
+		if err := q.IsValid(nil); err != nil {
+			return err
+		}
+		cmd.qualification = q
+	}
+
+	return nil
+}
+
+func (cmd *ReplaceSignerCommand) createOperation() (operation.Operation, error) {
+	item := collection.NewReplaceSignerItem(cmd.qualification, cmd.nft, cmd.old, cmd.new, cmd.Currency.CID)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := collection.NewReplaceSignerFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		[]collection.ReplaceSignerItem{item},
+	)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := collection.NewReplaceSigner(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create replace-signer operation")
+	}
+
+	return op, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
 	"github.com/ProtoconNet/mitum-nft/digest"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
 	"github.com/pkg/errors"
 
 	"github.com/spikeekips/mitum/base"
@@ -21,6 +22,7 @@ import (
 	basicstates "github.com/spikeekips/mitum/states/basic"
 	mongodbstorage "github.com/spikeekips/mitum/storage/mongodb"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/logging"
 	"github.com/ulule/limiter/v3"
 
@@ -249,6 +251,12 @@ func (cmd *RunCommand) setDigestHandlers(
 	}
 	handlers = i
 
+	if opr, err := loadOperationProcessor(ctx); err != nil {
+		return nil, err
+	} else if opr != nil {
+		handlers = handlers.SetOperationProcessor(opr)
+	}
+
 	if nc := design.Network(); nc != nil && nc.RateLimit() != nil {
 		if _, err := cmd.attachDigestRateLimit(ctx, handlers, nc.RateLimit()); err != nil {
 			return nil, err
@@ -258,6 +266,33 @@ func (cmd *RunCommand) setDigestHandlers(
 	return handlers, nil
 }
 
+func loadOperationProcessor(ctx context.Context) (*collection.OperationProcessor, error) {
+	var oprs *hint.Hintmap
+	if err := process.LoadOperationProcessorsContextValue(ctx, &oprs); err != nil {
+		if errors.Is(err, util.ContextValueNotFoundError) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if oprs == nil {
+		return nil, nil
+	}
+
+	i, err := oprs.Compatible(collection.MintHinter)
+	if err != nil {
+		return nil, nil
+	}
+
+	opr, ok := i.(*collection.OperationProcessor)
+	if !ok {
+		return nil, errors.Errorf("expected *collection.OperationProcessor, not %T", i)
+	}
+
+	return opr, nil
+}
+
 func (*RunCommand) enteringBootingState(ctx context.Context) (context.Context, error) {
 	var cs states.States
 	var bcs *basicstates.States
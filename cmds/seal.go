@@ -18,6 +18,8 @@ type SealCommand struct {
 	TransferNFTs            TransferCommand                            `cmd:"" name:"transfer-nfts" help:"transfer nfts"`
 	Burn                    BurnCommand                                `cmd:"" name:"burn" help:"burn nfts"`
 	SignNFTs                SignCommand                                `cmd:"" name:"sign-nfts" help:"sign nfts; creator | copyrighter"`
+	ReplaceSigner           ReplaceSignerCommand                       `cmd:"" name:"replace-signer" help:"propose nft signer replacement; creator | copyrighter"`
+	TransferShare           TransferShareCommand                       `cmd:"" name:"transfer-share" help:"transfer nft signer share; creator | copyrighter"`
 	Transfer                currencycmds.TransferCommand               `cmd:"" name:"transfer" help:"transfer big"`
 	KeyUpdater              currencycmds.KeyUpdaterCommand             `cmd:"" name:"key-updater" help:"update keys"`
 	CurrencyRegister        extensioncmds.CurrencyRegisterCommand      `cmd:"" name:"currency-register" help:"register new currency"`
@@ -41,6 +43,8 @@ func NewSealCommand() SealCommand {
 		TransferNFTs:            NewTransferCommand(),
 		Burn:                    NewBurnCommand(),
 		SignNFTs:                NewSignCommand(),
+		ReplaceSigner:           NewReplaceSignerCommand(),
+		TransferShare:           NewTransferShareCommand(),
 		Transfer:                currencycmds.NewTransferCommand(),
 		KeyUpdater:              currencycmds.NewKeyUpdaterCommand(),
 		CurrencyRegister:        extensioncmds.NewCurrencyRegisterCommand(),
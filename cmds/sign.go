@@ -17,10 +17,14 @@ type SignCommand struct {
 	Sender        AddressFlag                 `arg:"" name:"sender" help:"sender address; nft owner or agent" required:"true"`
 	Currency      currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
 	NFT           NFTIDFlag                   `arg:"" name:"nft" help:"target nft; \"<symbol>,<idx>\""`
-	Qualification string                      `name:"qualification" help:"target qualification; creator | copyrighter" optional:""`
+	Qualification string                      `name:"qualification" help:"target qualification; creator | copyrighter | creator-unsign | copyrighter-unsign" optional:""`
+	Hash          string                      `name:"hash" help:"nft hash to attest" optional:""`
+	Uri           string                      `name:"uri" help:"nft uri to attest; resolved uri of nft" optional:""`
 	sender        base.Address
 	nft           nft.NFTID
 	qualification collection.Qualification
+	hash          nft.NFTHash
+	uri           nft.URI
 }
 
 func NewSignCommand() SignCommand {
@@ -83,12 +87,35 @@ func (cmd *SignCommand) parseFlags() error {
 		cmd.qualification = q
 	}
 
+	if !cmd.qualification.Unsign() {
+		if len(cmd.Hash) < 1 {
+			return errors.Errorf("empty nft hash to attest")
+		}
+
+		hash := nft.NFTHash(cmd.Hash)
+		if err := hash.IsValid(nil); err != nil {
+			return err
+		}
+		cmd.hash = hash
+		cmd.uri = nft.URI(cmd.Uri)
+	}
+
 	return nil
 
 }
 
 func (cmd *SignCommand) createOperation() (operation.Operation, error) {
-	item := collection.NewSignItem(cmd.qualification, cmd.nft, cmd.Currency.CID)
+	var item collection.SignItem
+	if cmd.qualification.Unsign() {
+		item = collection.NewSignItem(cmd.qualification, cmd.nft, nil, nil, cmd.Currency.CID)
+	} else {
+		sig, err := cmd.Privatekey.Sign(nft.AttestationBody(cmd.NetworkID.NetworkID(), cmd.nft, cmd.hash, cmd.uri))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sign attestation")
+		}
+
+		item = collection.NewSignItem(cmd.qualification, cmd.nft, cmd.Privatekey.Publickey(), sig, cmd.Currency.CID)
+	}
 
 	fact := collection.NewSignFact(
 		[]byte(cmd.Token),
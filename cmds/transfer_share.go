@@ -0,0 +1,124 @@
+package cmds
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+)
+
+type TransferShareCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender        AddressFlag                 `arg:"" name:"sender" help:"sender address; nft signer" required:"true"`
+	Currency      currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	NFT           NFTIDFlag                   `arg:"" name:"nft" help:"target nft; \"<symbol>,<idx>\""`
+	Receiver      AddressFlag                 `arg:"" name:"receiver" help:"share receiver" required:"true"`
+	Share         uint                        `arg:"" name:"share" help:"share to transfer" required:"true"`
+	Qualification string                      `name:"qualification" help:"target qualification; creator | copyrighter" optional:""`
+	sender        base.Address
+	nft           nft.NFTID
+	receiver      base.Address
+	qualification collection.Qualification
+}
+
+func NewTransferShareCommand() TransferShareCommand {
+	return TransferShareCommand{
+		BaseCommand: NewBaseCommand("transfer-share-operation"),
+	}
+}
+
+func (cmd *TransferShareCommand) Run(version util.Version) error {
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *TransferShareCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid sender format; %q", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Receiver.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid receiver format; %q", cmd.Receiver)
+	} else {
+		cmd.receiver = a
+	}
+
+	n := nft.NewNFTID(cmd.NFT.collection, cmd.NFT.idx)
+	if err := n.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.nft = n
+
+	if cmd.Qualification == "" {
+		cmd.qualification = collection.CreatorQualification
+	} else {
+		q := collection.Qualification(cmd.Qualification)
+		if err := q.IsValid(nil); err != nil {
+			return err
+		}
+		cmd.qualification = q
+	}
+
+	return nil
+}
+
+func (cmd *TransferShareCommand) createOperation() (operation.Operation, error) {
+	item := collection.NewTransferShareItem(cmd.qualification, cmd.nft, cmd.receiver, cmd.Share, cmd.Currency.CID)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := collection.NewTransferShareFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		[]collection.TransferShareItem{item},
+	)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := collection.NewTransferShare(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create transfer-share operation")
+	}
+
+	return op, nil
+}
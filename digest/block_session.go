@@ -3,13 +3,16 @@ package digest
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
 	"github.com/ProtoconNet/mitum-nft/nft/collection"
 	"github.com/pkg/errors"
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/block"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
@@ -17,6 +20,7 @@ import (
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/tree"
 	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -35,8 +39,8 @@ type BlockSession struct {
 	nftCollectionModels   []mongo.WriteModel
 	nftModels             []mongo.WriteModel
 	nftAgentModels        []mongo.WriteModel
+	latestModels          map[string][]mongo.WriteModel
 	statesValue           *sync.Map
-	nftList               []string
 }
 
 func NewBlockSession(st *Database, blk block.Block) (*BlockSession, error) {
@@ -50,9 +54,10 @@ func NewBlockSession(st *Database, blk block.Block) (*BlockSession, error) {
 	}
 
 	return &BlockSession{
-		st:          nst,
-		block:       blk,
-		statesValue: &sync.Map{},
+		st:           nst,
+		block:        blk,
+		latestModels: map[string][]mongo.WriteModel{},
+		statesValue:  &sync.Map{},
 	}, nil
 }
 
@@ -107,22 +112,12 @@ func (bs *BlockSession) Commit(ctx context.Context) error {
 	}
 
 	if len(bs.nftModels) > 0 {
-		for i := range bs.nftList {
-			err := bs.st.cleanByHeightColNameNFTId(
-				ctx,
-				bs.block.Height(),
-				defaultColNameNFT,
-				bs.nftList[i],
-			)
-			if err != nil {
-				return err
-			}
+		if err := bs.writeNFTStats(ctx); err != nil {
+			return err
 		}
 
-		if len(bs.nftModels) > 0 {
-			if err := bs.writeModels(ctx, defaultColNameNFT, bs.nftModels); err != nil {
-				return err
-			}
+		if err := bs.writeModels(ctx, defaultColNameNFT, bs.nftModels); err != nil {
+			return err
 		}
 	}
 
@@ -132,7 +127,7 @@ func (bs *BlockSession) Commit(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	return bs.writeLatestModels(ctx)
 }
 
 func (bs *BlockSession) Close() error {
@@ -173,6 +168,11 @@ func (bs *BlockSession) prepareOperations() error {
 		return true, no.InState(), no.Reason()
 	}
 
+	nfts, err := bs.nftsByFact()
+	if err != nil {
+		return err
+	}
+
 	bs.operationModels = make([]mongo.WriteModel, len(bs.block.Operations()))
 
 	for i := range bs.block.Operations() {
@@ -195,12 +195,38 @@ func (bs *BlockSession) prepareOperations() error {
 		if err != nil {
 			return err
 		}
+		if ids, found := nfts[op.Fact().Hash().String()]; found {
+			doc = doc.withNFTs(ids)
+		}
 		bs.operationModels[i] = mongo.NewInsertOneModel().SetDocument(doc)
 	}
 
 	return nil
 }
 
+// nftsByFact returns the nfts of the block states by the operation facts,
+// which updated them.
+func (bs *BlockSession) nftsByFact() (map[string][]nft.NFTID, error) {
+	nfts := map[string][]nft.NFTID{}
+	for i := range bs.block.States() {
+		st := bs.block.States()[i]
+		if !collection.IsStateNFTKey(st.Key()) {
+			continue
+		}
+
+		n, err := collection.StateNFTValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, h := range st.Operations() {
+			nfts[h.String()] = append(nfts[h.String()], n.ID())
+		}
+	}
+
+	return nfts, nil
+}
+
 func (bs *BlockSession) prepareAccounts() error {
 	if len(bs.block.States()) < 1 {
 		return nil
@@ -245,6 +271,7 @@ func (bs *BlockSession) prepareAccounts() error {
 				return err
 			}
 			nftModels = append(nftModels, j...)
+
 		case collection.IsStateAgentKey(st.Key()):
 			j, err := bs.handleNFTAgentState(st)
 			if err != nil {
@@ -308,6 +335,8 @@ func (bs *BlockSession) handleNFTCollectionState(st state.State) ([]mongo.WriteM
 		return nil, err
 	}
 
+	bs.addLatestModel(defaultColNameNFTCollectionLatest, "symbol", doc.de.Symbol().String(), doc)
+
 	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
 }
 
@@ -317,7 +346,8 @@ func (bs *BlockSession) handleNFTState(st state.State) ([]mongo.WriteModel, erro
 		return nil, err
 	}
 
-	bs.nftList = append(bs.nftList, doc.va.nft.ID().String())
+	bs.addLatestModel(defaultColNameNFTLatest, "nftid", doc.va.nft.ID().String(), doc)
+
 	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
 }
 
@@ -327,9 +357,150 @@ func (bs *BlockSession) handleNFTAgentState(st state.State) ([]mongo.WriteModel,
 		return nil, err
 	}
 
+	bs.addLatestModel(defaultColNameNFTAgentLatest, "statekey", st.Key(), doc)
+
 	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
 }
 
+// addLatestModel replaces the state of key in the latest collection by doc.
+func (bs *BlockSession) addLatestModel(col, key, value string, doc interface{}) {
+	bs.latestModels[col] = append(bs.latestModels[col], mongo.NewReplaceOneModel().
+		SetFilter(bson.M{key: value}).
+		SetReplacement(doc).
+		SetUpsert(true),
+	)
+}
+
+// writeLatestModels updates the latest collections. The models replace the
+// states by their keys, so writing the block again gives the same latest
+// states.
+func (bs *BlockSession) writeLatestModels(ctx context.Context) error {
+	if len(bs.latestModels) < 1 {
+		return nil
+	}
+
+	started := time.Now()
+	defer func() {
+		bs.statesValue.Store("write-latest-models", time.Since(started))
+	}()
+
+	for i := range latestCollections {
+		col := latestCollections[i].col
+
+		models := bs.latestModels[col]
+		if len(models) < 1 {
+			continue
+		}
+
+		if _, err := bs.st.database.Client().Collection(col).BulkWrite(
+			ctx, models, options.BulkWrite().SetOrdered(true)); err != nil {
+			return storage.MergeStorageError(err)
+		}
+	}
+
+	return nil
+}
+
+// writeNFTStats updates the stats and the holders of the collections by the
+// nfts of block. The previous nfts are loaded, so it should be called before
+// the nfts are written.
+func (bs *BlockSession) writeNFTStats(ctx context.Context) error {
+	var nfts []nft.NFT
+	for i := range bs.block.States() {
+		st := bs.block.States()[i]
+		if !collection.IsStateNFTKey(st.Key()) {
+			continue
+		}
+
+		n, err := collection.StateNFTValue(st)
+		if err != nil {
+			return err
+		}
+		nfts = append(nfts, n)
+	}
+
+	ids := make([]string, len(nfts))
+	for i := range nfts {
+		ids[i] = nfts[i].ID().String()
+	}
+
+	previouses, err := bs.st.nftsByIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	deltas := map[string]*nftCollectionDelta{}
+	for i := range nfts {
+		var previous *nft.NFT
+		if pn, found := previouses[ids[i]]; found {
+			previous = &pn
+		}
+
+		addNFTChange(deltas, previous, nfts[i])
+	}
+
+	symbols := make([]string, 0, len(deltas))
+	for i := range deltas {
+		symbols = append(symbols, i)
+	}
+	sort.Strings(symbols)
+
+	height := bs.block.Height()
+
+	var statsModels, holderModels []mongo.WriteModel
+	for _, symbol := range symbols {
+		d := deltas[symbol]
+
+		stats, found, err := bs.st.NFTCollectionStats(symbol)
+		if err != nil {
+			return err
+		} else if !found {
+			stats = NewNFTCollectionStatsValue(symbol, 0, 0, 0, base.NilHeight, height)
+		}
+
+		holders := stats.Holders()
+		for _, owner := range d.changedHolders() {
+			count, err := bs.st.nftHolderCount(symbol, owner)
+			if err != nil {
+				return err
+			}
+
+			next := count + d.holders[owner]
+			switch {
+			case count < 1 && next > 0:
+				holders++
+			case count > 0 && next < 1:
+				holders--
+			}
+
+			holderModels = append(holderModels,
+				mongo.NewInsertOneModel().SetDocument(NewNFTHolderDoc(symbol, owner, next, height)))
+		}
+
+		lastMintHeight := stats.LastMintHeight()
+		if d.minted > 0 {
+			lastMintHeight = height
+		}
+
+		statsModels = append(statsModels, mongo.NewInsertOneModel().SetDocument(NewNFTCollectionStatsDoc(
+			NewNFTCollectionStatsValue(
+				symbol,
+				stats.Minted()+d.minted,
+				stats.Burned()+d.burned,
+				holders,
+				lastMintHeight,
+				height,
+			),
+		)))
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameNFTStats, statsModels); err != nil {
+		return err
+	}
+
+	return bs.writeModels(ctx, defaultColNameNFTHolder, holderModels)
+}
+
 func (bs *BlockSession) writeModels(ctx context.Context, col string, models []mongo.WriteModel) error {
 	started := time.Now()
 	defer func() {
@@ -383,6 +554,7 @@ func (bs *BlockSession) close() error {
 	bs.nftCollectionModels = nil
 	bs.nftModels = nil
 	bs.nftAgentModels = nil
+	bs.latestModels = nil
 
 	return bs.st.Close()
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
 	"github.com/pkg/errors"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
@@ -59,6 +60,22 @@ func (bl Builder) FactTemplate(ht hint.Hint) (Hal, error) {
 		return bl.templateCurrencyRegisterFact(), nil
 	case extensioncurrency.CurrencyPolicyUpdaterType:
 		return bl.templateCurrencyPolicyUpdaterFact(), nil
+	case collection.CollectionRegisterType:
+		return bl.templateCollectionRegisterFact(), nil
+	case collection.CollectionPolicyUpdaterType:
+		return bl.templateCollectionPolicyUpdaterFact(), nil
+	case collection.MintType:
+		return bl.templateMintFact(), nil
+	case collection.TransferType:
+		return bl.templateTransferFact(), nil
+	case collection.BurnType:
+		return bl.templateBurnFact(), nil
+	case collection.ApproveType:
+		return bl.templateApproveFact(), nil
+	case collection.DelegateType:
+		return bl.templateDelegateFact(), nil
+	case collection.SignType:
+		return bl.templateSignFact(), nil
 	default:
 		return nil, errors.Errorf("unknown operation, %q", ht)
 	}
@@ -182,6 +199,22 @@ func (bl Builder) BuildFact(b []byte) (Hal, error) {
 		return bl.buildFactCurrencyRegister(t)
 	case extensioncurrency.CurrencyPolicyUpdaterFact:
 		return bl.buildFactCurrencyPolicyUpdater(t)
+	case collection.CollectionRegisterFact:
+		return bl.buildFactCollectionRegister(t)
+	case collection.CollectionPolicyUpdaterFact:
+		return bl.buildFactCollectionPolicyUpdater(t)
+	case collection.MintFact:
+		return bl.buildFactMint(t)
+	case collection.TransferFact:
+		return bl.buildFactTransfer(t)
+	case collection.BurnFact:
+		return bl.buildFactBurn(t)
+	case collection.ApproveFact:
+		return bl.buildFactApprove(t)
+	case collection.DelegateFact:
+		return bl.buildFactDelegate(t)
+	case collection.SignFact:
+		return bl.buildFactSign(t)
 	default:
 		return nil, errors.Errorf("unknown fact, %T", fact)
 	}
@@ -493,6 +526,22 @@ func (bl Builder) BuildOperation(b []byte) (Hal, error) {
 			hal, err = bl.buildCurrencyRegister(t)
 		case extensioncurrency.CurrencyPolicyUpdater:
 			hal, err = bl.buildCurrencyPolicyUpdater(t)
+		case collection.CollectionRegister:
+			hal, err = bl.buildCollectionRegister(t)
+		case collection.CollectionPolicyUpdater:
+			hal, err = bl.buildCollectionPolicyUpdater(t)
+		case collection.Mint:
+			hal, err = bl.buildMint(t)
+		case collection.Transfer:
+			hal, err = bl.buildTransfer(t)
+		case collection.Burn:
+			hal, err = bl.buildBurn(t)
+		case collection.Approve:
+			hal, err = bl.buildApprove(t)
+		case collection.Delegate:
+			hal, err = bl.buildDelegate(t)
+		case collection.Sign:
+			hal, err = bl.buildSign(t)
 		default:
 			return errors.Errorf("unknown operation.Operation, %T", t)
 		}
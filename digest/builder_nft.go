@@ -0,0 +1,706 @@
+package digest
+
+import (
+	"bytes"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+)
+
+var (
+	templateCollection     = extensioncurrency.ContractID("yYy")
+	templateCollectionName = collection.CollectionName("mother of wolves")
+	templateNFT            = nft.NewNFTID(templateCollection, 1)
+	templateNFTHash        = nft.NewNFTHash(nft.SHA256HashAlgorithm, "b71cd7504a2346d2ce7567a44bab1a4b3e96ff1afc75139ac46cf4b50d53ce0a")
+	templateURI            = nft.URI("https://raised.by/wolves")
+)
+
+func (Builder) templateCollectionRegisterFact() Hal {
+	form := collection.NewCollectionRegisterForm(
+		templateReceiver,
+		templateCollection,
+		templateCollectionName,
+		nft.PaymentParameter(0),
+		templateURI,
+		[]base.Address{},
+		"",
+		nil,
+		false,
+		0,
+		"",
+	)
+	fact := collection.NewCollectionRegisterFact(templateToken, templateSender, form, templateCurrencyID)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":       templateToken,
+		"sender":      templateSender,
+		"form.target": templateReceiver,
+		"form.symbol": templateCollection,
+		"form.name":   templateCollectionName,
+		"form.uri":    templateURI,
+		"currency":    templateCurrencyID,
+	})
+}
+
+func (Builder) templateCollectionPolicyUpdaterFact() Hal {
+	po := collection.NewCollectionPolicy(
+		templateCollectionName,
+		nft.PaymentParameter(0),
+		templateURI,
+		[]base.Address{},
+		"",
+		nil,
+		false,
+		0,
+		"",
+	)
+	fact := collection.NewCollectionPolicyUpdaterFact(templateToken, templateSender, templateCollection, po, templateCurrencyID)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":       templateToken,
+		"sender":      templateSender,
+		"collection":  templateCollection,
+		"policy.name": templateCollectionName,
+		"policy.uri":  templateURI,
+		"currency":    templateCurrencyID,
+	})
+}
+
+func (Builder) templateMintFact() Hal {
+	form := collection.NewMintForm(
+		templateNFTHash,
+		templateURI,
+		nft.NewSigners(100, []nft.Signer{nft.NewSigner(templateReceiver, 100, false, nil, nil)}),
+		nft.NewSigners(0, []nft.Signer{}),
+	)
+	fact := collection.NewMintFact(
+		templateToken,
+		templateSender,
+		[]collection.MintItem{
+			collection.NewMintItem(templateCollection, form, []base.Address{}, []uint64{}, templateCurrencyID),
+		},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":                               templateToken,
+		"sender":                              templateSender,
+		"items.collection":                    templateCollection,
+		"items.form.hash":                     templateNFTHash,
+		"items.form.uri":                      templateURI,
+		"items.form.creators.signers.account": templateReceiver,
+		"items.currency":                      templateCurrencyID,
+	})
+}
+
+func (Builder) templateTransferFact() Hal {
+	fact := collection.NewTransferFact(
+		templateToken,
+		templateSender,
+		[]collection.TransferItem{collection.NewTransferItem(templateReceiver, templateNFT, templateCurrencyID)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":                templateToken,
+		"sender":               templateSender,
+		"items.receiver":       templateReceiver,
+		"items.nft.collection": templateCollection,
+		"items.currency":       templateCurrencyID,
+	})
+}
+
+func (Builder) templateBurnFact() Hal {
+	fact := collection.NewBurnFact(
+		templateToken,
+		templateSender,
+		[]collection.BurnItem{collection.NewBurnItem(templateNFT, templateCurrencyID)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":                templateToken,
+		"sender":               templateSender,
+		"items.nft.collection": templateCollection,
+		"items.currency":       templateCurrencyID,
+	})
+}
+
+func (Builder) templateApproveFact() Hal {
+	fact := collection.NewApproveFact(
+		templateToken,
+		templateSender,
+		[]collection.ApproveItem{collection.NewApproveItem(templateReceiver, templateNFT, templateCurrencyID)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":                templateToken,
+		"sender":               templateSender,
+		"items.approved":       templateReceiver,
+		"items.nft.collection": templateCollection,
+		"items.currency":       templateCurrencyID,
+	})
+}
+
+func (Builder) templateDelegateFact() Hal {
+	fact := collection.NewDelegateFact(
+		templateToken,
+		templateSender,
+		[]collection.DelegateItem{
+			collection.NewDelegateItem(templateCollection, templateReceiver, collection.DelegateAllow, templateCurrencyID),
+		},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.collection": templateCollection,
+		"items.agent":      templateReceiver,
+		"items.mode":       collection.DelegateAllow,
+		"items.currency":   templateCurrencyID,
+	})
+}
+
+func (Builder) templateSignFact() Hal {
+	fact := collection.NewSignFact(
+		templateToken,
+		templateSender,
+		[]collection.SignItem{
+			collection.NewSignItem(
+				collection.CreatorQualification,
+				templateNFT,
+				templatePublickey,
+				templateSignature,
+				templateCurrencyID,
+			),
+		},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":                templateToken,
+		"sender":               templateSender,
+		"items.qualification":  collection.CreatorQualification,
+		"items.nft.collection": templateCollection,
+		"items.publickey":      templatePublickey,
+		"items.signature":      templateSignature,
+		"items.currency":       templateCurrencyID,
+	})
+}
+
+func (bl Builder) buildFactCollectionRegister(fact collection.CollectionRegisterFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := collection.NewCollectionRegisterFact(token, fact.Sender(), fact.Form(), fact.Currency())
+	if err = bl.isValidFactCollectionRegister(nfact); err != nil {
+		return nil, err
+	}
+
+	return bl.buildNFTFactHal(nfact, func(fs []base.FactSign) (operation.Operation, error) {
+		op, err := collection.NewCollectionRegister(nfact, fs, "")
+
+		return op, err
+	})
+}
+
+func (bl Builder) buildFactCollectionPolicyUpdater(fact collection.CollectionPolicyUpdaterFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := collection.NewCollectionPolicyUpdaterFact(
+		token, fact.Sender(), fact.Collection(), fact.Policy(), fact.Currency())
+	if err = bl.isValidFactCollectionPolicyUpdater(nfact); err != nil {
+		return nil, err
+	}
+
+	return bl.buildNFTFactHal(nfact, func(fs []base.FactSign) (operation.Operation, error) {
+		op, err := collection.NewCollectionPolicyUpdater(nfact, fs, "")
+
+		return op, err
+	})
+}
+
+func (bl Builder) buildFactMint(fact collection.MintFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := collection.NewMintFact(token, fact.Sender(), fact.Items())
+	if err = bl.isValidFactMint(nfact); err != nil {
+		return nil, err
+	}
+
+	return bl.buildNFTFactHal(nfact, func(fs []base.FactSign) (operation.Operation, error) {
+		op, err := collection.NewMint(nfact, fs, "")
+
+		return op, err
+	})
+}
+
+func (bl Builder) buildFactTransfer(fact collection.TransferFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := collection.NewTransferFact(token, fact.Sender(), fact.Items())
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactTransfer(nfact); err != nil {
+		return nil, err
+	}
+
+	return bl.buildNFTFactHal(nfact, func(fs []base.FactSign) (operation.Operation, error) {
+		op, err := collection.NewTransfer(nfact, fs, "")
+
+		return op, err
+	})
+}
+
+func (bl Builder) buildFactBurn(fact collection.BurnFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := collection.NewBurnFact(token, fact.Sender(), fact.Items())
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactBurn(nfact); err != nil {
+		return nil, err
+	}
+
+	return bl.buildNFTFactHal(nfact, func(fs []base.FactSign) (operation.Operation, error) {
+		op, err := collection.NewBurn(nfact, fs, "")
+
+		return op, err
+	})
+}
+
+func (bl Builder) buildFactApprove(fact collection.ApproveFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := collection.NewApproveFact(token, fact.Sender(), fact.Items())
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactApprove(nfact); err != nil {
+		return nil, err
+	}
+
+	return bl.buildNFTFactHal(nfact, func(fs []base.FactSign) (operation.Operation, error) {
+		op, err := collection.NewApprove(nfact, fs, "")
+
+		return op, err
+	})
+}
+
+func (bl Builder) buildFactDelegate(fact collection.DelegateFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := collection.NewDelegateFact(token, fact.Sender(), fact.Items())
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactDelegate(nfact); err != nil {
+		return nil, err
+	}
+
+	return bl.buildNFTFactHal(nfact, func(fs []base.FactSign) (operation.Operation, error) {
+		op, err := collection.NewDelegate(nfact, fs, "")
+
+		return op, err
+	})
+}
+
+func (bl Builder) buildFactSign(fact collection.SignFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := collection.NewSignFact(token, fact.Sender(), fact.Items())
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactSign(nfact); err != nil {
+		return nil, err
+	}
+
+	return bl.buildNFTFactHal(nfact, func(fs []base.FactSign) (operation.Operation, error) {
+		op, err := collection.NewSign(nfact, fs, "")
+
+		return op, err
+	})
+}
+
+// buildNFTFactHal returns the operation of fact with the template factsign,
+// which should be replaced by the signature of signature_base.
+func (bl Builder) buildNFTFactHal(
+	fact base.Fact,
+	newOperation func([]base.FactSign) (operation.Operation, error),
+) (Hal, error) {
+	op, err := newOperation([]base.FactSign{
+		base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(op, HalLink{})
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(fact, bl.networkID)), nil
+}
+
+func (Builder) isValidFactCollectionRegister(fact collection.CollectionRegisterFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := isValidNFTFactHead(fact.Token(), fact.Sender()); err != nil {
+		return err
+	}
+
+	if fact.Form().Target().Equal(templateReceiver) {
+		return errors.Errorf("please set target; target is same with template default")
+	}
+
+	if fact.Form().Symbol() == templateCollection {
+		return errors.Errorf("please set symbol; symbol is same with template default")
+	}
+
+	if fact.Form().Name() == templateCollectionName {
+		return errors.Errorf("please set name; name is same with template default")
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactCollectionPolicyUpdater(fact collection.CollectionPolicyUpdaterFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := isValidNFTFactHead(fact.Token(), fact.Sender()); err != nil {
+		return err
+	}
+
+	if fact.Collection() == templateCollection {
+		return errors.Errorf("please set collection; collection is same with template default")
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactMint(fact collection.MintFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := isValidNFTFactHead(fact.Token(), fact.Sender()); err != nil {
+		return err
+	}
+
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+		if item.Collection() == templateCollection {
+			return errors.Errorf("please set collection; collection is same with template default")
+		}
+
+		if item.Form().NftHash() == templateNFTHash {
+			return errors.Errorf("please set hash; hash is same with template default")
+		}
+
+		if item.Form().Uri() == templateURI {
+			return errors.Errorf("please set uri; uri is same with template default")
+		}
+
+		as, err := item.Form().Addresses()
+		if err != nil {
+			return err
+		}
+
+		for j := range as {
+			if as[j].Equal(templateReceiver) {
+				return errors.Errorf("please set signer; signer is same with template default")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactTransfer(fact collection.TransferFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := isValidNFTFactHead(fact.Token(), fact.Sender()); err != nil {
+		return err
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].Receiver().Equal(templateReceiver) {
+			return errors.Errorf("please set receiver; receiver is same with template default")
+		}
+
+		if err := isValidNFTOfItem(fact.Items()[i].NFT()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactBurn(fact collection.BurnFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := isValidNFTFactHead(fact.Token(), fact.Sender()); err != nil {
+		return err
+	}
+
+	for i := range fact.Items() {
+		if err := isValidNFTOfItem(fact.Items()[i].NFT()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactApprove(fact collection.ApproveFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := isValidNFTFactHead(fact.Token(), fact.Sender()); err != nil {
+		return err
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].Approved().Equal(templateReceiver) {
+			return errors.Errorf("please set approved; approved is same with template default")
+		}
+
+		if err := isValidNFTOfItem(fact.Items()[i].NFT()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactDelegate(fact collection.DelegateFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := isValidNFTFactHead(fact.Token(), fact.Sender()); err != nil {
+		return err
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].Collection() == templateCollection {
+			return errors.Errorf("please set collection; collection is same with template default")
+		}
+
+		if fact.Items()[i].Agent().Equal(templateReceiver) {
+			return errors.Errorf("please set agent; agent is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactSign(fact collection.SignFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := isValidNFTFactHead(fact.Token(), fact.Sender()); err != nil {
+		return err
+	}
+
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+		if err := isValidNFTOfItem(item.NFT()); err != nil {
+			return err
+		}
+
+		if item.Publickey() != nil && item.Publickey().Equal(templatePublickey) {
+			return errors.Errorf("please set publickey; publickey is same with template default")
+		}
+
+		if len(item.Signature()) > 0 && item.Signature().Equal(templateSignature) {
+			return errors.Errorf("please set signature; signature is same with template default")
+		}
+	}
+
+	return nil
+}
+
+// isValidNFTFactHead checks the token and the sender, which every nft fact
+// has, are not the template default.
+func isValidNFTFactHead(token []byte, sender base.Address) error {
+	if bytes.Equal(token, templateToken) {
+		return errors.Errorf("please set token; token same with template default")
+	}
+
+	if sender.Equal(templateSender) {
+		return errors.Errorf("please set sender; sender is same with template default")
+	}
+
+	return nil
+}
+
+func isValidNFTOfItem(id nft.NFTID) error {
+	if id.Collection() == templateCollection {
+		return errors.Errorf("please set nft; nft is same with template default")
+	}
+
+	return nil
+}
+
+func (bl Builder) buildCollectionRegister(op collection.CollectionRegister) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := collection.NewCollectionRegister(op.Fact().(collection.CollectionRegisterFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactCollectionRegister(nop.Fact().(collection.CollectionRegisterFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildCollectionPolicyUpdater(op collection.CollectionPolicyUpdater) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := collection.NewCollectionPolicyUpdater(
+		op.Fact().(collection.CollectionPolicyUpdaterFact),
+		fs,
+		op.Memo,
+	); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactCollectionPolicyUpdater(nop.Fact().(collection.CollectionPolicyUpdaterFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildMint(op collection.Mint) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := collection.NewMint(op.Fact().(collection.MintFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactMint(nop.Fact().(collection.MintFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildTransfer(op collection.Transfer) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := collection.NewTransfer(op.Fact().(collection.TransferFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactTransfer(nop.Fact().(collection.TransferFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildBurn(op collection.Burn) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := collection.NewBurn(op.Fact().(collection.BurnFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactBurn(nop.Fact().(collection.BurnFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildApprove(op collection.Approve) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := collection.NewApprove(op.Fact().(collection.ApproveFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactApprove(nop.Fact().(collection.ApproveFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildDelegate(op collection.Delegate) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := collection.NewDelegate(op.Fact().(collection.DelegateFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactDelegate(nop.Fact().(collection.DelegateFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildSign(op collection.Sign) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := collection.NewSign(op.Fact().(collection.SignFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactSign(nop.Fact().(collection.SignFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
@@ -0,0 +1,108 @@
+package digest
+
+import (
+	"testing"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/stretchr/testify/suite"
+)
+
+type testBuilderNFT struct {
+	suite.Suite
+	networkID base.NetworkID
+}
+
+func (t *testBuilderNFT) SetupSuite() {
+	t.networkID = base.NetworkID(util.UUID().Bytes())
+}
+
+func (t *testBuilderNFT) TestFactTemplate() {
+	bl := NewBuilder(nil, t.networkID)
+
+	cases := []struct {
+		ht   hint.Hinter
+		fact base.Fact
+	}{
+		{collection.CollectionRegisterHinter, collection.CollectionRegisterFact{}},
+		{collection.CollectionPolicyUpdaterHinter, collection.CollectionPolicyUpdaterFact{}},
+		{collection.MintHinter, collection.MintFact{}},
+		{collection.TransferHinter, collection.TransferFact{}},
+		{collection.BurnHinter, collection.BurnFact{}},
+		{collection.ApproveHinter, collection.ApproveFact{}},
+		{collection.DelegateHinter, collection.DelegateFact{}},
+		{collection.SignHinter, collection.SignFact{}},
+	}
+
+	for i := range cases {
+		hal, err := bl.FactTemplate(cases[i].ht.Hint())
+		t.NoError(err)
+		t.IsType(cases[i].fact, hal.Interface())
+		t.Contains(hal.Extras(), "default")
+	}
+}
+
+func (t *testBuilderNFT) TestTemplateValues() {
+	t.NoError(templateNFTHash.IsValid(nil))
+
+	bl := NewBuilder(nil, t.networkID)
+
+	hal, err := bl.FactTemplate(collection.CollectionRegisterHinter.Hint())
+	t.NoError(err)
+
+	form := hal.Interface().(collection.CollectionRegisterFact).Form()
+	t.Empty(form.HashAlgorithm())
+	t.Empty(form.Schemes())
+	t.Empty(form.HashUniqueness())
+
+	hal, err = bl.FactTemplate(collection.CollectionPolicyUpdaterHinter.Hint())
+	t.NoError(err)
+
+	policy := hal.Interface().(collection.CollectionPolicyUpdaterFact).Policy()
+	t.Empty(policy.HashAlgorithm())
+	t.Empty(policy.Schemes())
+	t.Empty(policy.HashUniqueness())
+}
+
+func (t *testBuilderNFT) TestBuildFactTransfer() {
+	bl := NewBuilder(nil, t.networkID)
+
+	id := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+	fact := collection.NewTransferFact(util.UUID().Bytes(), nft.NewTestAddress(), []collection.TransferItem{
+		collection.NewTransferItem(nft.NewTestAddress(), id, "MCC"),
+	})
+
+	hal, err := bl.buildFactTransfer(fact)
+	t.NoError(err)
+
+	op, ok := hal.Interface().(collection.Transfer)
+	t.True(ok)
+	t.True(op.Fact().Hash().Equal(fact.Hash()))
+	t.Equal(base.NewBytesForFactSignature(fact, t.networkID), hal.Extras()["signature_base"])
+}
+
+func (t *testBuilderNFT) TestBuildFactTemplateDefaults() {
+	bl := NewBuilder(nil, t.networkID)
+
+	id := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+
+	_, err := bl.buildFactTransfer(collection.NewTransferFact(util.UUID().Bytes(), nft.NewTestAddress(),
+		[]collection.TransferItem{collection.NewTransferItem(templateReceiver, id, "MCC")}))
+	t.Contains(err.Error(), "please set receiver")
+
+	_, err = bl.buildFactApprove(collection.NewApproveFact(util.UUID().Bytes(), templateSender,
+		[]collection.ApproveItem{collection.NewApproveItem(nft.NewTestAddress(), id, "MCC")}))
+	t.Contains(err.Error(), "please set sender")
+
+	_, err = bl.buildFactDelegate(collection.NewDelegateFact(util.UUID().Bytes(), nft.NewTestAddress(),
+		[]collection.DelegateItem{collection.NewDelegateItem("ABC", templateReceiver, collection.DelegateAllow, "MCC")}))
+	t.Contains(err.Error(), "please set agent")
+}
+
+func TestBuilderNFT(t *testing.T) {
+	suite.Run(t, new(testBuilderNFT))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -33,13 +34,18 @@ import (
 var maxLimit int64 = 50
 
 var (
-	defaultColNameAccount       = "digest_ac"
-	defaultColNameExtension     = "digest_et"
-	defaultColNameBalance       = "digest_bl"
-	defaultColNameOperation     = "digest_op"
-	defaultColNameNFTCollection = "digest_nftcollection"
-	defaultColNameNFT           = "digest_nft"
-	defaultColNameNFTAgent      = "digest_nftagent"
+	defaultColNameAccount             = "digest_ac"
+	defaultColNameExtension           = "digest_et"
+	defaultColNameBalance             = "digest_bl"
+	defaultColNameOperation           = "digest_op"
+	defaultColNameNFTCollection       = "digest_nftcollection"
+	defaultColNameNFT                 = "digest_nft"
+	defaultColNameNFTAgent            = "digest_nftagent"
+	defaultColNameNFTStats            = "digest_nft_stats"
+	defaultColNameNFTHolder           = "digest_nft_holder"
+	defaultColNameNFTCollectionLatest = "digest_nftcollection_latest"
+	defaultColNameNFTLatest           = "digest_nft_latest"
+	defaultColNameNFTAgentLatest      = "digest_nftagent_latest"
 )
 
 var AllCollections = []string{
@@ -50,10 +56,22 @@ var AllCollections = []string{
 	defaultColNameNFTCollection,
 	defaultColNameNFT,
 	defaultColNameNFTAgent,
+	defaultColNameNFTStats,
+	defaultColNameNFTHolder,
+	defaultColNameNFTCollectionLatest,
+	defaultColNameNFTLatest,
+	defaultColNameNFTAgentLatest,
 }
 
 var DigestStorageLastBlockKey = "digest_last_block"
 
+// DigestStorageOperationIndexKey keeps the version of nftsOfFact, by which the
+// nft ids and collections of the operation documents were indexed.
+var (
+	DigestStorageOperationIndexKey = "digest_operation_index"
+	operationIndexVersion          = "v0.0.1"
+)
+
 type Database struct {
 	sync.RWMutex
 	*logging.Logging
@@ -128,6 +146,18 @@ func (st *Database) Initialize() error {
 			if err := st.cleanByHeight(context.Background(), h+1); err != nil {
 				return err
 			}
+
+			if err := st.rebuildLatest(context.Background()); err != nil {
+				return err
+			}
+
+			if err := st.rebuildNFTStats(context.Background()); err != nil {
+				return err
+			}
+
+			if err := st.reindexOperations(context.Background()); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -231,6 +261,10 @@ func (st *Database) cleanByHeight(ctx context.Context, height base.Height) error
 	removeByHeight := mongo.NewDeleteManyModel().SetFilter(bson.M{"height": bson.M{"$gte": height}})
 
 	for _, col := range AllCollections {
+		if isLatestCollection(col) {
+			continue
+		}
+
 		res, err := st.database.Client().Collection(col).BulkWrite(
 			ctx,
 			[]mongo.WriteModel{removeByHeight},
@@ -243,9 +277,400 @@ func (st *Database) cleanByHeight(ctx context.Context, height base.Height) error
 		st.Log().Debug().Str("collection", col).Interface("result", res).Msg("clean collection by height")
 	}
 
+	if err := st.cleanLatestByHeight(ctx, height); err != nil {
+		return err
+	}
+
 	return st.setLastBlock(height - 1)
 }
 
+// latestCollection is the projection of the latest states in the versioned
+// collection, from; key is the unique field of the state.
+type latestCollection struct {
+	col  string
+	from string
+	key  string
+}
+
+var latestCollections = []latestCollection{
+	{col: defaultColNameNFTCollectionLatest, from: defaultColNameNFTCollection, key: "symbol"},
+	{col: defaultColNameNFTLatest, from: defaultColNameNFT, key: "nftid"},
+	{col: defaultColNameNFTAgentLatest, from: defaultColNameNFTAgent, key: "statekey"},
+}
+
+func isLatestCollection(col string) bool {
+	for i := range latestCollections {
+		if latestCollections[i].col == col {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rebuildLatest fills the empty latest collections with the latest states of
+// the versioned collections, which are digested before the latest collections
+// are added.
+func (st *Database) rebuildLatest(ctx context.Context) error {
+	for i := range latestCollections {
+		l := latestCollections[i]
+
+		switch n, err := st.database.Client().Collection(l.col).CountDocuments(ctx, bson.M{}); {
+		case err != nil:
+			return storage.MergeStorageError(err)
+		case n > 0:
+			continue
+		}
+
+		count, err := st.rebuildLatestCollection(ctx, l)
+		if err != nil {
+			return err
+		}
+
+		st.Log().Debug().Str("collection", l.col).Int("states", count).Msg("rebuild latest collection")
+	}
+
+	return nil
+}
+
+func (st *Database) rebuildLatestCollection(ctx context.Context, l latestCollection) (int, error) {
+	cursor, err := st.database.Client().Collection(l.from).Aggregate(
+		ctx,
+		buildLatestPipeline(l.key),
+		options.Aggregate().SetAllowDiskUse(true),
+	)
+	if err != nil {
+		return 0, storage.MergeStorageError(err)
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var count int
+	var docs []interface{}
+	for cursor.Next(ctx) {
+		// NOTE Current is valid only until the next call of Next.
+		doc := make(bson.Raw, len(cursor.Current))
+		copy(doc, cursor.Current)
+		docs = append(docs, doc)
+		count++
+
+		if len(docs) < bulkWriteLimit {
+			continue
+		}
+
+		if _, err := st.database.Client().Collection(l.col).InsertMany(ctx, docs); err != nil {
+			return 0, storage.MergeStorageError(err)
+		}
+		docs = nil
+	}
+
+	if err := cursor.Err(); err != nil {
+		return 0, storage.MergeStorageError(err)
+	}
+
+	if len(docs) > 0 {
+		if _, err := st.database.Client().Collection(l.col).InsertMany(ctx, docs); err != nil {
+			return 0, storage.MergeStorageError(err)
+		}
+	}
+
+	return count, nil
+}
+
+// rebuildNFTStats builds the stats and the holders of the collections from the
+// latest nfts, which are digested before the stats are kept. It is done once,
+// while no stats are kept.
+func (st *Database) rebuildNFTStats(ctx context.Context) error {
+	switch n, err := st.database.Client().Collection(defaultColNameNFTStats).CountDocuments(ctx, bson.M{}); {
+	case err != nil:
+		return storage.MergeStorageError(err)
+	case n > 0:
+		return nil
+	}
+
+	deltas := map[string]*nftCollectionDelta{}
+	if err := st.database.Client().Find(
+		ctx,
+		defaultColNameNFTLatest,
+		bson.M{},
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := LoadNFT(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+			addLatestNFT(deltas, va.NFT())
+
+			return true, nil
+		},
+	); err != nil {
+		return err
+	}
+
+	if len(deltas) < 1 {
+		return nil
+	}
+
+	lastMints, err := st.lastMintHeights(ctx)
+	if err != nil {
+		return err
+	}
+
+	symbols := make([]string, 0, len(deltas))
+	for i := range deltas {
+		symbols = append(symbols, i)
+	}
+	sort.Strings(symbols)
+
+	var statsModels, holderModels []mongo.WriteModel
+	for _, symbol := range symbols {
+		d := deltas[symbol]
+
+		owners := d.changedHolders()
+		for _, owner := range owners {
+			holderModels = append(holderModels,
+				mongo.NewInsertOneModel().SetDocument(NewNFTHolderDoc(symbol, owner, d.holders[owner], st.lastBlock)))
+		}
+
+		lastMintHeight, found := lastMints[symbol]
+		if !found {
+			lastMintHeight = base.NilHeight
+		}
+
+		statsModels = append(statsModels, mongo.NewInsertOneModel().SetDocument(NewNFTCollectionStatsDoc(
+			NewNFTCollectionStatsValue(symbol, d.minted, d.burned, int64(len(owners)), lastMintHeight, st.lastBlock),
+		)))
+	}
+
+	if err := st.insertModels(ctx, defaultColNameNFTStats, statsModels); err != nil {
+		return err
+	}
+
+	if err := st.insertModels(ctx, defaultColNameNFTHolder, holderModels); err != nil {
+		return err
+	}
+
+	st.Log().Debug().Int("collections", len(symbols)).Msg("rebuild nft stats")
+
+	return nil
+}
+
+// lastMintHeights returns the height of the last minted nft by collection.
+func (st *Database) lastMintHeights(ctx context.Context) (map[string]base.Height, error) {
+	cursor, err := st.database.Client().Collection(defaultColNameNFT).Aggregate(
+		ctx,
+		buildLastMintPipeline(),
+		options.Aggregate().SetAllowDiskUse(true),
+	)
+	if err != nil {
+		return nil, storage.MergeStorageError(err)
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	heights := map[string]base.Height{}
+	for cursor.Next(ctx) {
+		var doc struct {
+			Collection string      `bson:"_id"`
+			Height     base.Height `bson:"height"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, storage.MergeStorageError(err)
+		}
+		heights[doc.Collection] = doc.Height
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, storage.MergeStorageError(err)
+	}
+
+	return heights, nil
+}
+
+// buildLastMintPipeline finds the height of the last minted nft of each
+// collection; nft is minted at the height of its first version.
+func buildLastMintPipeline() mongo.Pipeline {
+	return mongo.Pipeline{
+		{{"$group", bson.D{
+			{"_id", "$nftid"},
+			{"collection", bson.D{{"$first", "$collection"}}},
+			{"height", bson.D{{"$min", "$height"}}},
+		}}},
+		{{"$group", bson.D{{"_id", "$collection"}, {"height", bson.D{{"$max", "$height"}}}}}},
+	}
+}
+
+// insertModels writes models to col by bulkWriteLimit.
+func (st *Database) insertModels(ctx context.Context, col string, models []mongo.WriteModel) error {
+	opts := options.BulkWrite().SetOrdered(false)
+
+	for len(models) > 0 {
+		n := len(models)
+		if n > bulkWriteLimit {
+			n = bulkWriteLimit
+		}
+
+		if _, err := st.database.Client().Collection(col).BulkWrite(ctx, models[:n], opts); err != nil {
+			return storage.MergeStorageError(err)
+		}
+		models = models[n:]
+	}
+
+	return nil
+}
+
+// reindexOperations indexes the nft ids and collections of the operation
+// documents, which are digested before nftsOfFact knows their facts. It is
+// done once for each version of nftsOfFact.
+func (st *Database) reindexOperations(ctx context.Context) error {
+	switch b, found, err := st.database.Info(DigestStorageOperationIndexKey); {
+	case err != nil:
+		return err
+	case found && string(b) == operationIndexVersion:
+		return nil
+	}
+
+	opts := options.BulkWrite().SetOrdered(false)
+
+	var count int
+	var models []mongo.WriteModel
+	write := func() error {
+		if len(models) < 1 {
+			return nil
+		}
+
+		if _, err := st.database.Client().Collection(defaultColNameOperation).BulkWrite(ctx, models, opts); err != nil {
+			return storage.MergeStorageError(err)
+		}
+		count += len(models)
+		models = nil
+
+		return nil
+	}
+
+	if err := st.database.Client().Find(
+		ctx,
+		defaultColNameOperation,
+		bson.M{"nftids": bson.M{"$in": bson.A{nil, bson.A{}}}},
+		func(cursor *mongo.Cursor) (bool, error) {
+			var doc struct {
+				ID interface{} `bson:"_id"`
+			}
+			if err := cursor.Decode(&doc); err != nil {
+				return false, err
+			}
+
+			va, err := LoadOperation(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			nfts, collections := nftsOfFact(va.Operation().Fact())
+			if len(nfts) < 1 && len(collections) < 1 {
+				return true, nil
+			}
+
+			models = append(models, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"_id": doc.ID}).
+				SetUpdate(bson.M{"$set": bson.M{"nftids": nfts, "collections": collections}}),
+			)
+
+			if len(models) < bulkWriteLimit {
+				return true, nil
+			}
+
+			return true, write()
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := write(); err != nil {
+		return err
+	}
+
+	st.Log().Debug().Int("operations", count).Msg("reindex operations")
+
+	return st.database.SetInfo(DigestStorageOperationIndexKey, []byte(operationIndexVersion))
+}
+
+// buildLatestPipeline finds the last version of each state by key.
+func buildLatestPipeline(key string) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{"$sort", bson.D{{key, 1}, {"height", -1}}}},
+		{{"$group", bson.D{{"_id", "$" + key}, {"doc", bson.D{{"$first", "$$ROOT"}}}}}},
+		{{"$replaceRoot", bson.D{{"newRoot", "$doc"}}}},
+	}
+}
+
+// cleanLatestByHeight restores the latest collections to the versioned
+// collections, which are already cleaned by height. The states changed since
+// height are replaced by their latest remaining versions one by one, so it can
+// be done again after it stops in the middle.
+func (st *Database) cleanLatestByHeight(ctx context.Context, height base.Height) error {
+	for i := range latestCollections {
+		l := latestCollections[i]
+
+		keys, err := st.database.Client().Collection(l.col).Distinct(ctx, l.key, bson.M{"height": bson.M{"$gte": height}})
+		if err != nil {
+			return storage.MergeStorageError(err)
+		} else if len(keys) < 1 {
+			continue
+		}
+
+		for j := range keys {
+			if err := st.restoreLatest(ctx, l, keys[j]); err != nil {
+				return err
+			}
+		}
+
+		st.Log().Debug().Str("collection", l.col).Int("keys", len(keys)).Msg("clean latest collection by height")
+	}
+
+	return nil
+}
+
+// restoreLatest replaces the state of key in the latest collection by its last
+// version, or removes it if no version remains.
+func (st *Database) restoreLatest(ctx context.Context, l latestCollection, key interface{}) error {
+	var doc bson.D
+	switch err := st.database.Client().Collection(l.from).FindOne(
+		ctx,
+		bson.M{l.key: key},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	).Decode(&doc); {
+	case err == nil:
+	case errors.Is(err, mongo.ErrNoDocuments):
+		if _, err := st.database.Client().Collection(l.col).DeleteOne(ctx, bson.M{l.key: key}); err != nil {
+			return storage.MergeStorageError(err)
+		}
+
+		return nil
+	default:
+		return storage.MergeStorageError(err)
+	}
+
+	// NOTE _id of the latest document is kept.
+	replacement := make(bson.D, 0, len(doc))
+	for i := range doc {
+		if doc[i].Key != "_id" {
+			replacement = append(replacement, doc[i])
+		}
+	}
+
+	if _, err := st.database.Client().Collection(l.col).ReplaceOne(
+		ctx,
+		bson.M{l.key: key},
+		replacement,
+		options.Replace().SetUpsert(true),
+	); err != nil {
+		return storage.MergeStorageError(err)
+	}
+
+	return nil
+}
+
 func (st *Database) ManifestByHeight(height base.Height) (block.Manifest, bool, error) {
 	return st.mitum.ManifestByHeight(height)
 }
@@ -300,6 +725,50 @@ func (st *Database) OperationsByAddress(
 		return err
 	}
 
+	return st.operationsByFilter(filter, load, reverse, limit, callback)
+}
+
+// OperationsByNFT returns the operations of the nft.
+func (st *Database) OperationsByNFT(
+	id nft.NFTID,
+	load,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(valuehash.Hash /* fact hash */, OperationValue) (bool, error),
+) error {
+	filter, err := buildOperationsFilter("nftids", id.String(), offset, reverse)
+	if err != nil {
+		return err
+	}
+
+	return st.operationsByFilter(filter, load, reverse, limit, callback)
+}
+
+// OperationsByCollection returns the operations of the collection.
+func (st *Database) OperationsByCollection(
+	symbol extensioncurrency.ContractID,
+	load,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(valuehash.Hash /* fact hash */, OperationValue) (bool, error),
+) error {
+	filter, err := buildOperationsFilter("collections", symbol.String(), offset, reverse)
+	if err != nil {
+		return err
+	}
+
+	return st.operationsByFilter(filter, load, reverse, limit, callback)
+}
+
+func (st *Database) operationsByFilter(
+	filter bson.M,
+	load,
+	reverse bool,
+	limit int64,
+	callback func(valuehash.Hash /* fact hash */, OperationValue) (bool, error),
+) error {
 	sr := 1
 	if reverse {
 		sr = -1
@@ -565,16 +1034,12 @@ func (st *Database) NFTAgentByAddressAndSymbol(
 		return err
 	}
 
-	sr := -1
-
-	opt := options.Find().SetSort(
-		util.NewBSONFilter("height", sr).D(),
-	).SetLimit(1)
+	opt := options.Find().SetLimit(1)
 
 	var sta state.State
 	return st.database.Client().Find(
 		context.Background(),
-		defaultColNameNFTAgent,
+		defaultColNameNFTAgentLatest,
 		filter,
 		func(cursor *mongo.Cursor) (bool, error) {
 			i, err := LoadState(cursor.Decode, st.database.Encoders())
@@ -843,7 +1308,11 @@ func buildOffset(height base.Height, index uint64) string {
 }
 
 func buildOperationsFilterByAddress(address base.Address, offset string, reverse bool) (bson.M, error) {
-	filter := bson.M{"addresses": bson.M{"$in": []string{address.String()}}}
+	return buildOperationsFilter("addresses", address.String(), offset, reverse)
+}
+
+func buildOperationsFilter(field, value string, offset string, reverse bool) (bson.M, error) {
+	filter := bson.M{field: bson.M{"$in": []string{value}}}
 	if len(offset) > 0 {
 		height, index, err := parseOffset(offset)
 		if err != nil {
@@ -998,7 +1467,7 @@ func (st *Database) NFTCollection(symbol string) (nft.Design, base.Height, base.
 	lastHeight, previousHeight := base.NilHeight, base.NilHeight
 	var sta state.State
 	if err := st.database.Client().GetByFilter(
-		defaultColNameNFTCollection,
+		defaultColNameNFTCollectionLatest,
 		util.NewBSONFilter("symbol", symbol).D(),
 		func(res *mongo.SingleResult) error {
 			i, err := LoadState(res.Decode, st.database.Encoders())
@@ -1009,7 +1478,6 @@ func (st *Database) NFTCollection(symbol string) (nft.Design, base.Height, base.
 
 			return nil
 		},
-		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
 	); err != nil {
 		return nft.Design{}, lastHeight, previousHeight, err
 	}
@@ -1021,14 +1489,71 @@ func (st *Database) NFTCollection(symbol string) (nft.Design, base.Height, base.
 	return i, lastHeight, previousHeight, nil
 }
 
-func (st *Database) NFT(symbol string) (NFTValue, base.Height, base.Height, error) {
-	lastHeight, previousHeight := base.NilHeight, base.NilHeight
-	var va NFTValue
-	if err := st.database.Client().GetByFilter(
-		defaultColNameNFT,
-		util.NewBSONFilter("nftid", symbol).D(),
-		func(res *mongo.SingleResult) error {
-			i, err := LoadNFT(res.Decode, st.database.Encoders())
+// NFTCollectionsFilter filters the collections. The empty field is not
+// filtered; Active is "1" or "0" and Name is the prefix of the collection name.
+type NFTCollectionsFilter struct {
+	Parent  string
+	Creator string
+	Active  string
+	Name    string
+}
+
+// NFTCollections returns the collections ordered by symbol. offset is the
+// symbol.
+func (st *Database) NFTCollections(
+	f NFTCollectionsFilter,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(string /* symbol */, nft.Design) (bool, error),
+) error {
+	filter := buildNFTCollectionsFilter(f, offset, reverse)
+
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("symbol", sr).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameNFTCollectionLatest,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := LoadState(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			de, err := collection.StateCollectionValue(sta)
+			if err != nil {
+				return false, err
+			}
+			return callback(de.Symbol().String(), de)
+		},
+		opt,
+	)
+}
+
+// NFTCollectionStats returns the latest stats of the collection.
+func (st *Database) NFTCollectionStats(symbol string) (NFTCollectionStatsValue, bool, error) {
+	var va NFTCollectionStatsValue
+	if err := st.database.Client().GetByFilter(
+		defaultColNameNFTStats,
+		util.NewBSONFilter("collection", symbol).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadNFTCollectionStats(res.Decode)
 			if err != nil {
 				return err
 			}
@@ -1037,6 +1562,123 @@ func (st *Database) NFT(symbol string) (NFTValue, base.Height, base.Height, erro
 			return nil
 		},
 		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if errors.Is(err, util.NotFoundError) {
+			return va, false, nil
+		}
+
+		return va, false, err
+	}
+
+	return va, true, nil
+}
+
+// NFTHolders returns the owners of the collection ranked by the number of
+// nfts. offset is "<count>,<owner>".
+func (st *Database) NFTHolders(
+	symbol string,
+	offset string,
+	limit int64,
+	callback func(string /* offset */, NFTHolderValue) (bool, error),
+) error {
+	pipeline, err := buildNFTHoldersPipeline(symbol, offset, limit)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := st.database.Client().Collection(defaultColNameNFTHolder).Aggregate(context.Background(), pipeline)
+	if err != nil {
+		return storage.MergeStorageError(err)
+	}
+	defer func() {
+		_ = cursor.Close(context.Background())
+	}()
+
+	for cursor.Next(context.Background()) {
+		va, err := LoadNFTHolder(cursor.Decode, st.database.Encoder())
+		if err != nil {
+			return err
+		}
+
+		switch keep, err := callback(buildNFTHoldersOffset(va.Count(), va.Owner().String()), va); {
+		case err != nil:
+			return err
+		case !keep:
+			return nil
+		}
+	}
+
+	return storage.MergeStorageError(cursor.Err())
+}
+
+func (st *Database) nftHolderCount(symbol, owner string) (int64, error) {
+	var count int64
+	if err := st.database.Client().GetByFilter(
+		defaultColNameNFTHolder,
+		util.NewBSONFilter("holderkey", nftHolderKey(symbol, owner)).D(),
+		func(res *mongo.SingleResult) error {
+			var doc nftHolderDoc
+			if err := res.Decode(&doc); err != nil {
+				return err
+			}
+			count = doc.CT
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if errors.Is(err, util.NotFoundError) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// nftsByIDs returns the latest nfts of ids; the nfts not found are omitted.
+func (st *Database) nftsByIDs(ids []string) (map[string]nft.NFT, error) {
+	nfts := map[string]nft.NFT{}
+	if len(ids) < 1 {
+		return nfts, nil
+	}
+
+	if err := st.database.Client().Find(
+		context.Background(),
+		defaultColNameNFTLatest,
+		bson.M{"nftid": bson.M{"$in": ids}},
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := LoadNFT(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+			nfts[va.NFT().ID().String()] = va.NFT()
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return nfts, nil
+}
+
+func (st *Database) NFT(symbol string) (NFTValue, base.Height, base.Height, error) {
+	lastHeight, previousHeight := base.NilHeight, base.NilHeight
+	var va NFTValue
+	if err := st.database.Client().GetByFilter(
+		defaultColNameNFTLatest,
+		util.NewBSONFilter("nftid", symbol).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadNFT(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			va = i
+
+			return nil
+		},
 	); err != nil {
 		return NFTValue{}, lastHeight, previousHeight, err
 	}
@@ -1063,7 +1705,7 @@ func (st *Database) NFTsByAddress(
 	}
 
 	opt := options.Find().SetSort(
-		util.NewBSONFilter("height", sr).D(),
+		util.NewBSONFilter("nftid", sr).D(),
 	)
 
 	switch {
@@ -1076,7 +1718,99 @@ func (st *Database) NFTsByAddress(
 
 	return st.database.Client().Find(
 		context.Background(),
-		defaultColNameNFT,
+		defaultColNameNFTLatest,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := LoadNFT(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+			return callback(va.NFT().ID().String(), va)
+		},
+		opt,
+	)
+}
+
+// NFTsByApproved returns the active nfts approved to the address by the other
+// owners.
+func (st *Database) NFTsByApproved(
+	address base.Address,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(string /* nft id */, NFTValue) (bool, error),
+) error {
+	filter := buildNFTsFilterByApproved(address, offset, reverse)
+
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("nftid", sr).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameNFTLatest,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := LoadNFT(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+			return callback(va.NFT().ID().String(), va)
+		},
+		opt,
+	)
+}
+
+// NFTsBySigner returns the nfts, which have the address in creators or in
+// copyrighters by role. signed is "1" for the signed, "0" for the active and
+// unsigned ones, or empty for all.
+func (st *Database) NFTsBySigner(
+	address base.Address,
+	role string,
+	signed string,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(string /* nft id */, NFTValue) (bool, error),
+) error {
+	filter, err := buildNFTsFilterBySigner(address, role, signed, offset, reverse)
+	if err != nil {
+		return err
+	}
+
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("nftid", sr).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameNFTLatest,
 		filter,
 		func(cursor *mongo.Cursor) (bool, error) {
 			va, err := LoadNFT(cursor.Decode, st.database.Encoders())
@@ -1089,6 +1823,49 @@ func (st *Database) NFTsByAddress(
 	)
 }
 
+// NFTDelegators returns the owners, who have the agent in their latest agents
+// of the collection. offset is the agents state key.
+func (st *Database) NFTDelegators(
+	agent base.Address,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(string /* state key */, NFTDelegatorValue) (bool, error),
+) error {
+	filter := buildNFTDelegatorsFilter(agent, offset, reverse)
+
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("statekey", sr).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameNFTAgentLatest,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := LoadNFTDelegator(cursor.Decode, st.database.Encoders(), st.database.Encoder())
+			if err != nil {
+				return false, err
+			}
+			return callback(collection.StateKeyAgents(va.Owner(), va.Agents().Collection()), va)
+		},
+		opt,
+	)
+}
+
 func (st *Database) NFTsByCollection(
 	symbol string,
 	reverse bool,
@@ -1107,7 +1884,7 @@ func (st *Database) NFTsByCollection(
 	}
 
 	opt := options.Find().SetSort(
-		util.NewBSONFilter("height", sr).D(),
+		util.NewBSONFilter("nftid", sr).D(),
 	)
 
 	switch {
@@ -1120,7 +1897,7 @@ func (st *Database) NFTsByCollection(
 
 	return st.database.Client().Find(
 		context.Background(),
-		defaultColNameNFT,
+		defaultColNameNFTLatest,
 		filter,
 		func(cursor *mongo.Cursor) (bool, error) {
 			va, err := LoadNFT(cursor.Decode, st.database.Encoders())
@@ -1133,33 +1910,199 @@ func (st *Database) NFTsByCollection(
 	)
 }
 
-func (st *Database) cleanByHeightColNameNFTId(
-	ctx context.Context,
-	height base.Height,
-	colName string,
-	nftid string,
+// NFTHistory returns the nfts of every height nft is changed, ordered by
+// height. offset is height.
+func (st *Database) NFTHistory(
+	id string,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(NFTValue, []string /* facts */) (bool, error),
 ) error {
-	if height <= base.PreGenesisHeight+1 {
-		return st.clean(ctx)
+	filter, err := buildNFTHistoryFilter(id, offset, reverse)
+	if err != nil {
+		return err
 	}
 
-	opts := options.BulkWrite().SetOrdered(true)
-	removeByHeight := mongo.NewDeleteManyModel().SetFilter(
-		bson.M{"nftid": nftid, "height": bson.M{"$lte": height}},
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("height", sr).D(),
 	)
 
-	res, err := st.database.Client().Collection(colName).BulkWrite(
-		ctx,
-		[]mongo.WriteModel{removeByHeight},
-		opts,
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameNFT,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, facts, err := LoadNFTHistory(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+			return callback(va, facts)
+		},
+		opt,
 	)
+}
+
+// NFTBefore returns the nft of the last change before height.
+func (st *Database) NFTBefore(id string, height base.Height) (NFTValue, bool, error) {
+	var va NFTValue
+	var found bool
+	if err := st.database.Client().Find(
+		context.Background(),
+		defaultColNameNFT,
+		bson.D{{"nftid", id}, {"height", bson.D{{"$lt", height}}}},
+		func(cursor *mongo.Cursor) (bool, error) {
+			i, _, err := LoadNFTHistory(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+			va = i
+			found = true
+
+			return false, nil
+		},
+		options.Find().SetSort(util.NewBSONFilter("height", -1).D()).SetLimit(1),
+	); err != nil {
+		return NFTValue{}, false, err
+	}
+
+	return va, found, nil
+}
+
+// NFTAt returns the nft as of the height.
+func (st *Database) NFTAt(id string, height base.Height) (NFTValue, bool, error) {
+	return st.NFTBefore(id, height+1)
+}
+
+// NFTsByAddressAt returns the nfts owned by the address as of the height.
+func (st *Database) NFTsByAddressAt(
+	address base.Address,
+	height base.Height,
+	reverse bool,
+	offset string,
+	limit int64,
+	collectionid string,
+	callback func(string /* nft id */, NFTValue) (bool, error),
+) error {
+	match := bson.M{}
+	if len(collectionid) > 0 {
+		match["collection"] = collectionid
+	}
+
+	return st.nftsAt(match, bson.M{"owner": address.String()}, height, reverse, offset, limit, callback)
+}
+
+// NFTsByCollectionAt returns the nfts of the collection as of the height.
+func (st *Database) NFTsByCollectionAt(
+	symbol string,
+	height base.Height,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(string /* nft id */, NFTValue) (bool, error),
+) error {
+	return st.nftsAt(bson.M{"collection": symbol}, nil, height, reverse, offset, limit, callback)
+}
+
+// nftsAt finds the last version of each nft until height, which is matched
+// by match, and returns them matched by latest.
+func (st *Database) nftsAt(
+	match bson.M,
+	latest bson.M,
+	height base.Height,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(string /* nft id */, NFTValue) (bool, error),
+) error {
+	pipeline := buildNFTsAtPipeline(match, latest, height, reverse, offset, limit)
+
+	cursor, err := st.database.Client().Collection(defaultColNameNFT).Aggregate(context.Background(), pipeline)
 	if err != nil {
 		return storage.MergeStorageError(err)
 	}
+	defer func() {
+		_ = cursor.Close(context.Background())
+	}()
 
-	st.Log().Debug().Str("collection", colName).Interface("result", res).Msg("clean collection by height")
+	for cursor.Next(context.Background()) {
+		va, _, err := LoadNFTHistory(cursor.Decode, st.database.Encoders())
+		if err != nil {
+			return err
+		}
 
-	return st.setLastBlock(height - 1)
+		switch keep, err := callback(va.NFT().ID().String(), va); {
+		case err != nil:
+			return err
+		case !keep:
+			return nil
+		}
+	}
+
+	return storage.MergeStorageError(cursor.Err())
+}
+
+func buildNFTsAtPipeline(
+	match bson.M,
+	latest bson.M,
+	height base.Height,
+	reverse bool,
+	offset string,
+	limit int64,
+) mongo.Pipeline {
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	m := bson.M{"height": bson.M{"$lte": height}}
+	for k := range match {
+		m[k] = match[k]
+	}
+
+	if len(offset) > 0 {
+		if reverse {
+			m["nftid"] = bson.M{"$lt": offset}
+		} else {
+			m["nftid"] = bson.M{"$gt": offset}
+		}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{"$match", m}},
+		{{"$sort", bson.D{{"nftid", sr}, {"height", -1}}}},
+		{{"$group", bson.D{{"_id", "$nftid"}, {"doc", bson.D{{"$first", "$$ROOT"}}}}}},
+		{{"$replaceRoot", bson.D{{"newRoot", "$doc"}}}},
+	}
+
+	if len(latest) > 0 {
+		pipeline = append(pipeline, bson.D{{"$match", latest}})
+	}
+
+	pipeline = append(pipeline, bson.D{{"$sort", bson.D{{"nftid", sr}}}})
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		pipeline = append(pipeline, bson.D{{"$limit", maxLimit}})
+	default:
+		pipeline = append(pipeline, bson.D{{"$limit", limit}})
+	}
+
+	return pipeline
 }
 
 func buildNFTsFilterByAddress(address base.Address, offset string, reverse bool, collection string) (bson.D, error) {
@@ -1203,6 +2146,172 @@ func buildNFTsFilterByAddress(address base.Address, offset string, reverse bool,
 	return filter, nil
 }
 
+func buildNFTsFilterByApproved(address base.Address, offset string, reverse bool) bson.M {
+	filter := bson.M{
+		"approved": address.String(),
+		"owner":    bson.M{"$ne": address.String()},
+		"active":   true,
+	}
+
+	if len(offset) > 0 {
+		if reverse {
+			filter["nftid"] = bson.M{"$lt": offset}
+		} else {
+			filter["nftid"] = bson.M{"$gt": offset}
+		}
+	}
+
+	return filter
+}
+
+func buildNFTCollectionsFilter(f NFTCollectionsFilter, offset string, reverse bool) bson.M {
+	filter := bson.M{}
+
+	if len(f.Parent) > 0 {
+		filter["parent"] = f.Parent
+	}
+
+	if len(f.Creator) > 0 {
+		filter["creator"] = f.Creator
+	}
+
+	switch f.Active {
+	case "1":
+		filter["active"] = true
+	case "0":
+		filter["active"] = false
+	}
+
+	if len(f.Name) > 0 {
+		filter["name"] = bson.M{"$regex": "^" + regexp.QuoteMeta(f.Name)}
+	}
+
+	if len(offset) > 0 {
+		if reverse {
+			filter["symbol"] = bson.M{"$lt": offset}
+		} else {
+			filter["symbol"] = bson.M{"$gt": offset}
+		}
+	}
+
+	return filter
+}
+
+func buildNFTHoldersFilter(symbol string, offset string) (bson.M, error) {
+	filter := bson.M{"collection": symbol}
+	if len(offset) < 1 {
+		return filter, nil
+	}
+
+	var count int64
+	var owner string
+	switch n := strings.SplitN(offset, ",", 2); {
+	case len(n) < 2:
+		return nil, errors.Errorf("invalid offset, %q", offset)
+	default:
+		i, err := strconv.ParseInt(n[0], 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid count of offset")
+		}
+		count = i
+		owner = n[1]
+	}
+
+	filter["$or"] = []bson.M{
+		{"count": bson.M{"$lt": count}},
+		{"$and": []bson.M{
+			{"count": count},
+			{"owner": bson.M{"$gt": owner}},
+		}},
+	}
+
+	return filter, nil
+}
+
+// buildNFTHoldersPipeline finds the last count of each owner in the
+// collection and ranks the owners, who hold nfts, by the count.
+func buildNFTHoldersPipeline(symbol string, offset string, limit int64) (mongo.Pipeline, error) {
+	filter, err := buildNFTHoldersFilter(symbol, offset)
+	if err != nil {
+		return nil, err
+	}
+	filter["count"] = bson.M{"$gt": 0}
+
+	pipeline := mongo.Pipeline{
+		{{"$match", bson.M{"collection": symbol}}},
+		{{"$sort", bson.D{{"holderkey", 1}, {"height", -1}}}},
+		{{"$group", bson.D{{"_id", "$holderkey"}, {"doc", bson.D{{"$first", "$$ROOT"}}}}}},
+		{{"$replaceRoot", bson.D{{"newRoot", "$doc"}}}},
+		{{"$match", filter}},
+		{{"$sort", bson.D{{"count", -1}, {"owner", 1}}}},
+	}
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		pipeline = append(pipeline, bson.D{{"$limit", maxLimit}})
+	default:
+		pipeline = append(pipeline, bson.D{{"$limit", limit}})
+	}
+
+	return pipeline, nil
+}
+
+func buildNFTHoldersOffset(count int64, owner string) string {
+	return fmt.Sprintf("%d,%s", count, owner)
+}
+
+func buildNFTsFilterBySigner(
+	address base.Address,
+	role string,
+	signed string,
+	offset string,
+	reverse bool,
+) (bson.M, error) {
+	switch role {
+	case "creators", "copyrighters":
+	default:
+		return nil, errors.Errorf("unknown signer role, %q", role)
+	}
+
+	filter := bson.M{role: address.String()}
+
+	switch signed {
+	case "":
+	case "1":
+		filter["signed"] = address.String()
+	case "0":
+		filter["signed"] = bson.M{"$ne": address.String()}
+		filter["active"] = true
+	default:
+		return nil, errors.Errorf("invalid signed, %q", signed)
+	}
+
+	if len(offset) > 0 {
+		if reverse {
+			filter["nftid"] = bson.M{"$lt": offset}
+		} else {
+			filter["nftid"] = bson.M{"$gt": offset}
+		}
+	}
+
+	return filter, nil
+}
+
+func buildNFTDelegatorsFilter(agent base.Address, offset string, reverse bool) bson.M {
+	filter := bson.M{"agents": bson.M{"$in": []string{agent.String()}}}
+
+	if len(offset) > 0 {
+		if reverse {
+			filter["statekey"] = bson.M{"$lt": offset}
+		} else {
+			filter["statekey"] = bson.M{"$gt": offset}
+		}
+	}
+
+	return filter
+}
+
 func buildNFTsFilterByCollection(symbol string, offset string, reverse bool) (bson.D, error) {
 	filterA := bson.A{}
 
@@ -1235,3 +2344,22 @@ func buildNFTsFilterByCollection(symbol string, offset string, reverse bool) (bs
 
 	return filter, nil
 }
+
+func buildNFTHistoryFilter(id string, offset string, reverse bool) (bson.D, error) {
+	filterA := bson.A{bson.D{{"nftid", id}}}
+
+	if len(offset) > 0 {
+		height, err := base.NewHeightFromString(offset)
+		if err != nil {
+			return nil, err
+		}
+
+		if !reverse {
+			filterA = append(filterA, bson.D{{"height", bson.D{{"$gt", height}}}})
+		} else {
+			filterA = append(filterA, bson.D{{"height", bson.D{{"$lt", height}}}})
+		}
+	}
+
+	return bson.D{{"$and", filterA}}, nil
+}
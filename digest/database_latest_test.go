@@ -0,0 +1,41 @@
+package digest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type testLatestCollections struct {
+	suite.Suite
+}
+
+func (t *testLatestCollections) TestRegistered() {
+	for i := range latestCollections {
+		l := latestCollections[i]
+
+		t.Contains(AllCollections, l.col)
+		t.Contains(AllCollections, l.from)
+		t.Contains(defaultIndexes, l.col)
+		t.True(isLatestCollection(l.col))
+		t.False(isLatestCollection(l.from))
+	}
+}
+
+func (t *testLatestCollections) TestNotLatest() {
+	t.False(isLatestCollection(defaultColNameNFTStats))
+	t.False(isLatestCollection(defaultColNameOperation))
+}
+
+func (t *testLatestCollections) TestRebuildPipeline() {
+	pipeline := buildLatestPipeline("nftid")
+
+	t.Equal(3, len(pipeline))
+	t.Equal(bson.D{{"$sort", bson.D{{"nftid", 1}, {"height", -1}}}}, pipeline[0])
+	t.Equal(bson.D{{"_id", "$nftid"}, {"doc", bson.D{{"$first", "$$ROOT"}}}}, pipeline[1][0].Value)
+}
+
+func TestLatestCollections(t *testing.T) {
+	suite.Run(t, new(testLatestCollections))
+}
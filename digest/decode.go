@@ -1,7 +1,9 @@
 package digest
 
 import (
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
 	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/state"
 	mongodbstorage "github.com/spikeekips/mitum/storage/mongodb"
 	"github.com/spikeekips/mitum/util/encoder"
@@ -115,3 +117,88 @@ func LoadNFT(decoder func(interface{}) error, encs *encoder.Encoders) (NFTValue,
 		return va, nil
 	}
 }
+
+func LoadNFTHistory(decoder func(interface{}) error, encs *encoder.Encoders) (NFTValue, []string, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return NFTValue{}, nil, err
+	}
+
+	var doc struct {
+		FC []string `bson:"facts"`
+	}
+	if err := bson.Unmarshal(b, &doc); err != nil {
+		return NFTValue{}, nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return NFTValue{}, nil, err
+	} else if va, ok := hinter.(NFTValue); !ok {
+		return NFTValue{}, nil, errors.Errorf("not NFTValue : %T", hinter)
+	} else {
+		return va, doc.FC, nil
+	}
+}
+
+func LoadNFTDelegator(
+	decoder func(interface{}) error,
+	encs *encoder.Encoders,
+	enc encoder.Encoder,
+) (NFTDelegatorValue, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return NFTDelegatorValue{}, err
+	}
+
+	var doc struct {
+		AD string `bson:"address"`
+	}
+	if err := bson.Unmarshal(b, &doc); err != nil {
+		return NFTDelegatorValue{}, err
+	}
+
+	owner, err := base.DecodeAddressFromString(doc.AD, enc)
+	if err != nil {
+		return NFTDelegatorValue{}, err
+	}
+
+	_, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs)
+	if err != nil {
+		return NFTDelegatorValue{}, err
+	}
+
+	st, ok := hinter.(state.State)
+	if !ok {
+		return NFTDelegatorValue{}, errors.Errorf("not state.State: %T", hinter)
+	}
+
+	agents, err := collection.StateAgentsValue(st)
+	if err != nil {
+		return NFTDelegatorValue{}, err
+	}
+
+	return NewNFTDelegatorValue(owner, agents, st.Height()), nil
+}
+
+func LoadNFTCollectionStats(decoder func(interface{}) error) (NFTCollectionStatsValue, error) {
+	var doc nftCollectionStatsDoc
+	if err := decoder(&doc); err != nil {
+		return NFTCollectionStatsValue{}, err
+	}
+
+	return NewNFTCollectionStatsValue(doc.CL, doc.MT, doc.BN, doc.HD, doc.LM, doc.HT), nil
+}
+
+func LoadNFTHolder(decoder func(interface{}) error, enc encoder.Encoder) (NFTHolderValue, error) {
+	var doc nftHolderDoc
+	if err := decoder(&doc); err != nil {
+		return NFTHolderValue{}, err
+	}
+
+	owner, err := base.DecodeAddressFromString(doc.OW, enc)
+	if err != nil {
+		return NFTHolderValue{}, err
+	}
+
+	return NewNFTHolderValue(doc.CL, owner, doc.CT, doc.HT), nil
+}
@@ -39,7 +39,16 @@ func (doc NFTCollectionDoc) MarshalBSON() ([]byte, error) {
 		return nil, err
 	}
 
+	var name string
+	if policy, ok := doc.de.Policy().(collection.CollectionPolicy); ok {
+		name = policy.Name().String()
+	}
+
 	m["symbol"] = doc.de.Symbol()
+	m["parent"] = doc.de.Parent().String()
+	m["creator"] = doc.de.Creator().String()
+	m["active"] = doc.de.Active()
+	m["name"] = name
 	m["height"] = doc.st.Height()
 
 	return bsonenc.Marshal(m)
@@ -49,6 +58,7 @@ type NFTDoc struct {
 	mongodbstorage.BaseDoc
 	va        NFTValue
 	addresses []string
+	facts     []string
 	owner     string
 	height    base.Height
 }
@@ -72,10 +82,16 @@ func NewNFTDoc(st state.State, enc encoder.Encoder, height base.Height) (NFTDoc,
 		return NFTDoc{}, err
 	}
 
+	facts := make([]string, len(st.Operations()))
+	for i := range st.Operations() {
+		facts[i] = st.Operations()[i].String()
+	}
+
 	return NFTDoc{
 		BaseDoc:   b,
 		va:        va,
 		addresses: addresses,
+		facts:     facts,
 		owner:     n.Owner().String(),
 		height:    height,
 	}, nil
@@ -90,11 +106,35 @@ func (doc NFTDoc) MarshalBSON() ([]byte, error) {
 	m["collection"] = doc.va.nft.ID().Collection()
 	m["nftid"] = doc.va.nft.ID().String()
 	m["owner"] = doc.va.nft.Owner()
+	m["approved"] = doc.va.nft.Approved()
+	m["active"] = doc.va.nft.Active()
+	m["creators"] = signerAddresses(doc.va.nft.Creators(), false)
+	m["copyrighters"] = signerAddresses(doc.va.nft.Copyrighters(), false)
+	m["signed"] = append(
+		signerAddresses(doc.va.nft.Creators(), true),
+		signerAddresses(doc.va.nft.Copyrighters(), true)...,
+	)
+	m["facts"] = doc.facts
 	m["height"] = doc.height
 
 	return bsonenc.Marshal(m)
 }
 
+// signerAddresses returns the addresses of signers; if onlySigned, only the
+// signed ones.
+func signerAddresses(signers nft.Signers, onlySigned bool) []string {
+	addresses := []string{}
+	for _, signer := range signers.Signers() {
+		if onlySigned && !signer.Signed() {
+			continue
+		}
+
+		addresses = append(addresses, signer.Account().String())
+	}
+
+	return addresses
+}
+
 type NFTAgentDoc struct {
 	mongodbstorage.BaseDoc
 	st     state.State
@@ -124,10 +164,91 @@ func (doc NFTAgentDoc) MarshalBSON() ([]byte, error) {
 		return nil, err
 	}
 
-	address := doc.st.Key()[:len(doc.st.Key())-len(doc.agents.Collection().String())-len(collection.StateKeyAgentsSuffix)-1]
+	agents := make([]string, len(doc.agents.Agents()))
+	for i := range doc.agents.Agents() {
+		agents[i] = doc.agents.Agents()[i].String()
+	}
+
+	m["statekey"] = doc.st.Key()
 	m["collectionid"] = doc.agents.Collection().String()
-	m["address"] = address
+	m["address"] = agentsOwner(doc.st.Key(), doc.agents)
+	m["agents"] = agents
 	m["height"] = doc.st.Height()
 
 	return bsonenc.Marshal(m)
 }
+
+// agentsOwner returns the owner address string of the agents state key.
+func agentsOwner(key string, agents collection.AgentBox) string {
+	return key[:len(key)-len(agents.Collection().String())-len(collection.StateKeyAgentsSuffix)-1]
+}
+
+// NFTCollectionStatsDoc keeps the stats of the collection at every height it
+// is changed.
+type NFTCollectionStatsDoc struct {
+	va NFTCollectionStatsValue
+}
+
+func NewNFTCollectionStatsDoc(va NFTCollectionStatsValue) NFTCollectionStatsDoc {
+	return NFTCollectionStatsDoc{va: va}
+}
+
+func (doc NFTCollectionStatsDoc) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(nftCollectionStatsDoc{
+		CL: doc.va.collection,
+		MT: doc.va.minted,
+		BN: doc.va.burned,
+		HD: doc.va.holders,
+		LM: doc.va.lastMintHeight,
+		HT: doc.va.height,
+	})
+}
+
+type nftCollectionStatsDoc struct {
+	CL string      `bson:"collection"`
+	MT int64       `bson:"minted"`
+	BN int64       `bson:"burned"`
+	HD int64       `bson:"holders"`
+	LM base.Height `bson:"last_mint_height"`
+	HT base.Height `bson:"height"`
+}
+
+// NFTHolderDoc keeps the count of the owner in the collection at height. The
+// count of the owner, who does not hold nfts anymore, is kept as 0.
+type NFTHolderDoc struct {
+	collection string
+	owner      string
+	count      int64
+	height     base.Height
+}
+
+func NewNFTHolderDoc(collection, owner string, count int64, height base.Height) NFTHolderDoc {
+	return NFTHolderDoc{
+		collection: collection,
+		owner:      owner,
+		count:      count,
+		height:     height,
+	}
+}
+
+func (doc NFTHolderDoc) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(nftHolderDoc{
+		HK: nftHolderKey(doc.collection, doc.owner),
+		CL: doc.collection,
+		OW: doc.owner,
+		CT: doc.count,
+		HT: doc.height,
+	})
+}
+
+type nftHolderDoc struct {
+	HK string      `bson:"holderkey"`
+	CL string      `bson:"collection"`
+	OW string      `bson:"owner"`
+	CT int64       `bson:"count"`
+	HT base.Height `bson:"height"`
+}
+
+func nftHolderKey(collection, owner string) string {
+	return collection + "," + owner
+}
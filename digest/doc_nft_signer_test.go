@@ -0,0 +1,55 @@
+package digest
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type testNFTSigner struct {
+	suite.Suite
+}
+
+func (t *testNFTSigner) TestSignerAddresses() {
+	signed := nft.NewTestAddress()
+	unsigned := nft.NewTestAddress()
+
+	signers := nft.NewSigners(100, []nft.Signer{
+		nft.NewSigner(signed, 50, true, nil, nil),
+		nft.NewSigner(unsigned, 50, false, nil, nil),
+	})
+
+	t.Equal([]string{signed.String(), unsigned.String()}, signerAddresses(signers, false))
+	t.Equal([]string{signed.String()}, signerAddresses(signers, true))
+	t.Equal([]string{}, signerAddresses(nft.NewSigners(0, []nft.Signer{}), true))
+}
+
+func (t *testNFTSigner) TestFilter() {
+	address := nft.NewTestAddress()
+
+	filter, err := buildNFTsFilterBySigner(address, "creators", "", "", false)
+	t.NoError(err)
+	t.Equal(bson.M{"creators": address.String()}, filter)
+
+	filter, err = buildNFTsFilterBySigner(address, "copyrighters", "1", "", false)
+	t.NoError(err)
+	t.Equal(address.String(), filter["signed"])
+
+	filter, err = buildNFTsFilterBySigner(address, "creators", "0", "ABC-00001", true)
+	t.NoError(err)
+	t.Equal(bson.M{"$ne": address.String()}, filter["signed"])
+	t.Equal(true, filter["active"])
+	t.Equal(bson.M{"$lt": "ABC-00001"}, filter["nftid"])
+
+	_, err = buildNFTsFilterBySigner(address, "owner", "", "", false)
+	t.Error(err)
+
+	_, err = buildNFTsFilterBySigner(address, "creators", "yes", "", false)
+	t.Error(err)
+}
+
+func TestNFTSigner(t *testing.T) {
+	suite.Run(t, new(testNFTSigner))
+}
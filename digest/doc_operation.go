@@ -3,6 +3,8 @@ package digest
 import (
 	"time"
 
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
@@ -13,10 +15,12 @@ import (
 
 type OperationDoc struct {
 	mongodbstorage.BaseDoc
-	va        OperationValue
-	op        operation.Operation
-	addresses []string
-	height    base.Height
+	va          OperationValue
+	op          operation.Operation
+	addresses   []string
+	nfts        []string
+	collections []string
+	height      base.Height
 }
 
 func NewOperationDoc(
@@ -40,6 +44,8 @@ func NewOperationDoc(
 		}
 	}
 
+	nfts, collections := nftsOfFact(op.Fact())
+
 	va := NewOperationValue(op, height, confirmedAt, inState, reason, index)
 	b, err := mongodbstorage.NewBaseDoc(nil, va, enc)
 	if err != nil {
@@ -47,14 +53,26 @@ func NewOperationDoc(
 	}
 
 	return OperationDoc{
-		BaseDoc:   b,
-		va:        va,
-		op:        op,
-		addresses: addresses,
-		height:    height,
+		BaseDoc:     b,
+		va:          va,
+		op:          op,
+		addresses:   addresses,
+		nfts:        nfts,
+		collections: collections,
+		height:      height,
 	}, nil
 }
 
+// withNFTs adds the nfts, which are not known by the fact, like minted nfts.
+func (doc OperationDoc) withNFTs(ids []nft.NFTID) OperationDoc {
+	for i := range ids {
+		doc.nfts = appendUnique(doc.nfts, ids[i].String())
+		doc.collections = appendUnique(doc.collections, ids[i].Collection().String())
+	}
+
+	return doc
+}
+
 func (doc OperationDoc) MarshalBSON() ([]byte, error) {
 	m, err := doc.BaseDoc.M()
 	if err != nil {
@@ -62,9 +80,69 @@ func (doc OperationDoc) MarshalBSON() ([]byte, error) {
 	}
 
 	m["addresses"] = doc.addresses
+	m["nftids"] = doc.nfts
+	m["collections"] = doc.collections
 	m["fact"] = doc.op.Fact().Hash()
 	m["height"] = doc.height
 	m["index"] = doc.va.index
 
 	return bsonenc.Marshal(m)
 }
+
+// nftsOfFact returns the nft ids and the collection symbols of the nft
+// operation fact.
+func nftsOfFact(fact base.Fact) ([]string, []string) {
+	var nfts, collections []string
+
+	addNFT := func(id nft.NFTID) {
+		nfts = appendUnique(nfts, id.String())
+		collections = appendUnique(collections, id.Collection().String())
+	}
+
+	switch t := fact.(type) {
+	case collection.MintFact:
+		for _, it := range t.Items() {
+			collections = appendUnique(collections, it.Collection().String())
+		}
+	case collection.TransferFact:
+		for _, it := range t.Items() {
+			addNFT(it.NFT())
+		}
+	case collection.BurnFact:
+		for _, it := range t.Items() {
+			addNFT(it.NFT())
+		}
+	case collection.ApproveFact:
+		for _, it := range t.Items() {
+			addNFT(it.NFT())
+		}
+	case collection.DelegateFact:
+		for _, it := range t.Items() {
+			collections = appendUnique(collections, it.Collection().String())
+		}
+	case collection.SignFact:
+		for _, it := range t.Items() {
+			addNFT(it.NFT())
+		}
+	case collection.ReplaceSignerFact:
+		for _, it := range t.Items() {
+			addNFT(it.NFT())
+		}
+	case collection.TransferShareFact:
+		for _, it := range t.Items() {
+			addNFT(it.NFT())
+		}
+	}
+
+	return nfts, collections
+}
+
+func appendUnique(l []string, s string) []string {
+	for i := range l {
+		if l[i] == s {
+			return l
+		}
+	}
+
+	return append(l, s)
+}
@@ -0,0 +1,80 @@
+package digest
+
+import (
+	"testing"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/stretchr/testify/suite"
+)
+
+type testOperationNFTs struct {
+	suite.Suite
+}
+
+func (t *testOperationNFTs) TestTransfer() {
+	abc0 := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+	abc1 := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 2)
+	def0 := nft.NewNFTID(extensioncurrency.ContractID("DEF"), 1)
+
+	fact := collection.NewTransferFact([]byte("token"), nft.NewTestAddress(), []collection.TransferItem{
+		collection.NewTransferItem(nft.NewTestAddress(), abc0, "MCC"),
+		collection.NewTransferItem(nft.NewTestAddress(), abc1, "MCC"),
+		collection.NewTransferItem(nft.NewTestAddress(), def0, "MCC"),
+	})
+
+	nfts, collections := nftsOfFact(fact)
+	t.Equal([]string{abc0.String(), abc1.String(), def0.String()}, nfts)
+	t.Equal([]string{"ABC", "DEF"}, collections)
+}
+
+func (t *testOperationNFTs) TestDelegate() {
+	fact := collection.NewDelegateFact([]byte("token"), nft.NewTestAddress(), []collection.DelegateItem{
+		collection.NewDelegateItem(extensioncurrency.ContractID("ABC"), nft.NewTestAddress(), collection.DelegateAllow, "MCC"),
+	})
+
+	nfts, collections := nftsOfFact(fact)
+	t.Empty(nfts)
+	t.Equal([]string{"ABC"}, collections)
+}
+
+func (t *testOperationNFTs) TestReplaceSigner() {
+	abc0 := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+
+	fact := collection.NewReplaceSignerFact([]byte("token"), nft.NewTestAddress(), []collection.ReplaceSignerItem{
+		collection.NewReplaceSignerItem(collection.CreatorQualification, abc0, nft.NewTestAddress(), nft.NewTestAddress(), "MCC"),
+	})
+
+	nfts, collections := nftsOfFact(fact)
+	t.Equal([]string{abc0.String()}, nfts)
+	t.Equal([]string{"ABC"}, collections)
+}
+
+func (t *testOperationNFTs) TestTransferShare() {
+	abc0 := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+	def0 := nft.NewNFTID(extensioncurrency.ContractID("DEF"), 1)
+
+	fact := collection.NewTransferShareFact([]byte("token"), nft.NewTestAddress(), []collection.TransferShareItem{
+		collection.NewTransferShareItem(collection.CreatorQualification, abc0, nft.NewTestAddress(), 10, "MCC"),
+		collection.NewTransferShareItem(collection.CreatorQualification, def0, nft.NewTestAddress(), 10, "MCC"),
+	})
+
+	nfts, collections := nftsOfFact(fact)
+	t.Equal([]string{abc0.String(), def0.String()}, nfts)
+	t.Equal([]string{"ABC", "DEF"}, collections)
+}
+
+func (t *testOperationNFTs) TestMintedNFTs() {
+	minted := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+
+	doc := OperationDoc{collections: []string{"ABC"}}
+	doc = doc.withNFTs([]nft.NFTID{minted})
+
+	t.Equal([]string{minted.String()}, doc.nfts)
+	t.Equal([]string{"ABC"}, doc.collections)
+}
+
+func TestOperationNFTs(t *testing.T) {
+	suite.Run(t, new(testOperationNFTs))
+}
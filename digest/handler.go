@@ -7,6 +7,7 @@ import (
 	"time"
 
 	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
@@ -44,14 +45,25 @@ var (
 	HandlerPathAccounts                   = `/accounts`
 	HandlerPathAccountNFTAgent            = `/account/{address:(?i)` + base.REStringAddressString + `}/nftagent/{symbol:.*}` // revive:disable-line:line-length-limit
 	HandlerPathAccountNFTs                = `/account/{address:(?i)` + base.REStringAddressString + `}/nfts`                 // revive:disable-line:line-length-limit
+	HandlerPathAccountCreatedNFTs         = `/account/{address:(?i)` + base.REStringAddressString + `}/nfts/created`         // revive:disable-line:line-length-limit
+	HandlerPathAccountCopyrightedNFTs     = `/account/{address:(?i)` + base.REStringAddressString + `}/nfts/copyrighted`     // revive:disable-line:line-length-limit
+	HandlerPathAccountApprovals           = `/account/{address:(?i)` + base.REStringAddressString + `}/approvals`            // revive:disable-line:line-length-limit
+	HandlerPathAccountDelegators          = `/account/{address:(?i)` + base.REStringAddressString + `}/delegators`           // revive:disable-line:line-length-limit
+	HandlerPathNFTCollections             = `/nft/collections`
 	HandlerPathNFTCollection              = `/nft/collection/{symbol:[A-Z0-9][A-Z0-9_\.\!\$\*\@]*[A-Z0-9]+}`
 	HandlerPathNFT                        = `/nft/{id:.*}`
+	HandlerPathNFTHistory                 = `/nft/{id:[^/]+}/history`
+	HandlerPathNFTOperations              = `/nft/{id:[^/]+}/operations`
+	HandlerPathNFTCollectionStats         = `/nft/collection/{symbol:[A-Z0-9][A-Z0-9_\.\!\$\*\@]*[A-Z0-9]+}/stats`
+	HandlerPathNFTCollectionHolders       = `/nft/collection/{symbol:[A-Z0-9][A-Z0-9_\.\!\$\*\@]*[A-Z0-9]+}/holders`
+	HandlerPathNFTCollectionOperations    = `/nft/collection/{symbol:[A-Z0-9][A-Z0-9_\.\!\$\*\@]*[A-Z0-9]+}/operations`
 	HandlerPathNFTCollectionNFTs          = `/nft/collection/{symbol:[A-Z0-9][A-Z0-9_\.\!\$\*\@]*[A-Z0-9]+}/nfts`
 	HandlerPathOperationBuildFactTemplate = `/builder/operation/fact/template/{fact:[\w][\w\-]*}`
 	HandlerPathOperationBuildFact         = `/builder/operation/fact`
 	HandlerPathOperationBuildSign         = `/builder/operation/sign`
 	HandlerPathOperationBuild             = `/builder/operation`
 	HandlerPathSend                       = `/builder/send`
+	HandlerPathOperationSimulate          = `/builder/simulate`
 )
 
 var RateLimitHandlerMap = map[string]string{
@@ -71,14 +83,25 @@ var RateLimitHandlerMap = map[string]string{
 	"accounts":                        HandlerPathAccounts,
 	"account-nftagent":                HandlerPathAccountNFTAgent,
 	"account-nfts":                    HandlerPathAccountNFTs,
+	"account-created-nfts":            HandlerPathAccountCreatedNFTs,
+	"account-copyrighted-nfts":        HandlerPathAccountCopyrightedNFTs,
+	"account-approvals":               HandlerPathAccountApprovals,
+	"account-delegators":              HandlerPathAccountDelegators,
+	"nft-collections":                 HandlerPathNFTCollections,
 	"nft-collection":                  HandlerPathNFTCollection,
 	"nft":                             HandlerPathNFT,
+	"nft-history":                     HandlerPathNFTHistory,
+	"nft-operations":                  HandlerPathNFTOperations,
+	"nft-collection-operations":       HandlerPathNFTCollectionOperations,
+	"nft-collection-stats":            HandlerPathNFTCollectionStats,
+	"nft-collection-holders":          HandlerPathNFTCollectionHolders,
 	"nft-collection-nfts":             HandlerPathNFTCollectionNFTs,
 	"builder-operation-fact-template": HandlerPathOperationBuildFactTemplate,
 	"builder-operation-fact":          HandlerPathOperationBuildFact,
 	"builder-operation-sign":          HandlerPathOperationBuildSign,
 	"builder-operation":               HandlerPathOperationBuild,
 	"builder-send":                    HandlerPathSend,
+	"builder-simulate":                HandlerPathOperationSimulate,
 }
 
 var (
@@ -106,6 +129,7 @@ type Handlers struct {
 	cp              *extensioncurrency.CurrencyPool
 	nodeInfoHandler network.NodeInfoHandler
 	send            func(interface{}) (seal.Seal, error)
+	opr             *collection.OperationProcessor
 	router          *mux.Router
 	routes          map[ /* path */ string]*mux.Route
 	itemsLimiter    func(string /* request type */) int64
@@ -203,12 +227,32 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathAccountNFTs, hd.handleAccountNFTs, true).
 		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathAccountCreatedNFTs, hd.handleAccountCreatedNFTs, true).
+		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathAccountCopyrightedNFTs, hd.handleAccountCopyrightedNFTs, true).
+		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathAccountApprovals, hd.handleAccountApprovals, true).
+		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathAccountDelegators, hd.handleAccountDelegators, true).
+		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathAccounts, hd.handleAccounts, true).
 		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathNFTCollections, hd.handleNFTCollections, true).
+		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathNFTCollection, hd.handleNFTCollection, true).
 		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathNFTCollectionNFTs, hd.handleCollectionNFTs, true).
 		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathNFTCollectionOperations, hd.handleNFTCollectionOperations, true).
+		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathNFTCollectionStats, hd.handleNFTCollectionStats, true).
+		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathNFTCollectionHolders, hd.handleNFTCollectionHolders, true).
+		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathNFTHistory, hd.handleNFTHistory, true).
+		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathNFTOperations, hd.handleNFTOperations, true).
+		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathNFT, hd.handleNFT, true).
 		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathOperationBuildFactTemplate, hd.handleOperationBuildFactTemplate, true).
@@ -221,6 +265,8 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, http.MethodGet, http.MethodPost)
 	hd.setHandler(HandlerPathSend, hd.handleSend, false).
 		Methods(http.MethodOptions, http.MethodPost)
+	hd.setHandler(HandlerPathOperationSimulate, hd.handleSimulate, false).
+		Methods(http.MethodOptions, http.MethodPost)
 	hd.setHandler(HandlerPathNodeInfo, hd.handleNodeInfo, true).
 		Methods(http.MethodOptions, "GET")
 }
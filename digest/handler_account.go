@@ -535,10 +535,18 @@ func (hd *Handlers) handleAccountNFTs(w http.ResponseWriter, r *http.Request) {
 	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
 	collectionid := parseStringQuery(r.URL.Query().Get("collection"))
 
+	height, err := parseHeightQuery(r.URL.Query().Get("height"))
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
 	cachekey := CacheKey(
 		r.URL.Path, stringOffsetQuery(offset),
 		stringBoolQuery("reverse", reverse),
 		stringCollectionQuery(collectionid),
+		stringHeightQuery(height),
 	)
 
 	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
@@ -546,7 +554,7 @@ func (hd *Handlers) handleAccountNFTs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		i, filled, err := hd.handleAccountNFTsInGroup(address, offset, reverse, limit, collectionid)
+		i, filled, err := hd.handleAccountNFTsInGroup(address, height, offset, reverse, limit, collectionid)
 
 		return []interface{}{i, filled}, err
 	})
@@ -580,6 +588,7 @@ func (hd *Handlers) handleAccountNFTs(w http.ResponseWriter, r *http.Request) {
 
 func (hd *Handlers) handleAccountNFTsInGroup(
 	address base.Address,
+	height base.Height,
 	offset string,
 	reverse bool,
 	l int64,
@@ -593,24 +602,30 @@ func (hd *Handlers) handleAccountNFTsInGroup(
 	}
 
 	var vas []Hal
-	if err := hd.database.NFTsByAddress(
-		address, reverse, offset, limit, collectionid,
-		func(_ string, va NFTValue) (bool, error) {
-			hal, err := hd.buildNFTHal(va)
-			if err != nil {
-				return false, err
-			}
-			vas = append(vas, hal)
+	callback := func(_ string, va NFTValue) (bool, error) {
+		hal, err := hd.buildNFTHal(va)
+		if err != nil {
+			return false, err
+		}
+		vas = append(vas, hal)
 
-			return true, nil
-		},
-	); err != nil {
+		return true, nil
+	}
+
+	var err error
+	if height > base.NilHeight {
+		err = hd.database.NFTsByAddressAt(address, height, reverse, offset, limit, collectionid, callback)
+	} else {
+		err = hd.database.NFTsByAddress(address, reverse, offset, limit, collectionid, callback)
+	}
+
+	if err != nil {
 		return nil, false, err
 	} else if len(vas) < 1 {
 		return nil, false, util.NotFoundError.Errorf("nfts not found")
 	}
 
-	i, err := hd.buildAccountNFTsHal(address, vas, offset, reverse, collectionid)
+	i, err := hd.buildAccountNFTsHal(address, height, vas, offset, reverse, collectionid)
 	if err != nil {
 		return nil, false, err
 	}
@@ -621,6 +636,7 @@ func (hd *Handlers) handleAccountNFTsInGroup(
 
 func (hd *Handlers) buildAccountNFTsHal(
 	address base.Address,
+	height base.Height,
 	vas []Hal,
 	offset string,
 	reverse bool,
@@ -630,6 +646,7 @@ func (hd *Handlers) buildAccountNFTsHal(
 	if err != nil {
 		return nil, err
 	}
+	baseSelf = addQueryValue(baseSelf, stringHeightQuery(height))
 
 	self := baseSelf
 	if len(offset) > 0 {
@@ -677,6 +694,7 @@ func (hd *Handlers) buildAccountNFTsHal(
 
 func (hd *Handlers) buildCollectionNFTsHal(
 	symbol string,
+	height base.Height,
 	vas []Hal,
 	offset string,
 	reverse bool,
@@ -685,6 +703,7 @@ func (hd *Handlers) buildCollectionNFTsHal(
 	if err != nil {
 		return nil, err
 	}
+	baseSelf = addQueryValue(baseSelf, stringHeightQuery(height))
 
 	self := baseSelf
 	if len(offset) > 0 {
@@ -0,0 +1,273 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+)
+
+func (hd *Handlers) handleAccountApprovals(w http.ResponseWriter, r *http.Request) {
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	hd.handlePaged(w, r, time.Minute, func(offset string, limit int64, reverse bool) ([]byte, bool, error) {
+		return hd.handleAccountApprovalsInGroup(address, offset, limit, reverse)
+	})
+}
+
+func (hd *Handlers) handleAccountApprovalsInGroup(
+	address base.Address,
+	offset string,
+	l int64,
+	reverse bool,
+) ([]byte, bool, error) {
+	limit := l
+	if l < 0 {
+		limit = hd.itemsLimiter("account-approvals")
+	}
+
+	var vas []Hal
+	if err := hd.database.NFTsByApproved(
+		address, reverse, offset, limit,
+		func(_ string, va NFTValue) (bool, error) {
+			hal, err := hd.buildNFTHal(va)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, err
+	} else if len(vas) < 1 {
+		return nil, false, util.NotFoundError.Errorf("approved nfts not found")
+	}
+
+	var nextoffset string
+	if len(vas) > 0 {
+		nextoffset = vas[len(vas)-1].Interface().(NFTValue).NFT().ID().String()
+	}
+
+	i, err := hd.buildAccountPagedHal(HandlerPathAccountApprovals, address, vas, offset, nextoffset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.enc.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) handleAccountDelegators(w http.ResponseWriter, r *http.Request) {
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	hd.handlePaged(w, r, time.Minute, func(offset string, limit int64, reverse bool) ([]byte, bool, error) {
+		return hd.handleAccountDelegatorsInGroup(address, offset, limit, reverse)
+	})
+}
+
+func (hd *Handlers) handleAccountDelegatorsInGroup(
+	address base.Address,
+	offset string,
+	l int64,
+	reverse bool,
+) ([]byte, bool, error) {
+	limit := l
+	if l < 0 {
+		limit = hd.itemsLimiter("account-delegators")
+	}
+
+	var vas []Hal
+	var nextoffset string
+	if err := hd.database.NFTDelegators(
+		address, reverse, offset, limit,
+		func(key string, va NFTDelegatorValue) (bool, error) {
+			h, err := hd.combineURL(
+				HandlerPathAccountNFTAgent,
+				"address", va.Owner().String(),
+				"symbol", va.Agents().Collection().String(),
+			)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, NewBaseHal(va, NewHalLink(h, nil)))
+			nextoffset = key
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, err
+	} else if len(vas) < 1 {
+		return nil, false, util.NotFoundError.Errorf("delegators not found")
+	}
+
+	i, err := hd.buildAccountPagedHal(HandlerPathAccountDelegators, address, vas, offset, nextoffset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.enc.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) handleAccountCreatedNFTs(w http.ResponseWriter, r *http.Request) {
+	hd.handleAccountSignerNFTs(w, r, "creators", HandlerPathAccountCreatedNFTs)
+}
+
+func (hd *Handlers) handleAccountCopyrightedNFTs(w http.ResponseWriter, r *http.Request) {
+	hd.handleAccountSignerNFTs(w, r, "copyrighters", HandlerPathAccountCopyrightedNFTs)
+}
+
+// handleAccountSignerNFTs serves the nfts, which have the address in the
+// signers of role. "signed" query filters the signed, "1", or the unsigned,
+// "0", by the address.
+func (hd *Handlers) handleAccountSignerNFTs(w http.ResponseWriter, r *http.Request, role, path string) {
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	signed := parseStringQuery(r.URL.Query().Get("signed"))
+	switch signed {
+	case "", "0", "1":
+	default:
+		HTTP2ProblemWithError(w, errors.Errorf("invalid signed query, %q", signed), http.StatusBadRequest)
+
+		return
+	}
+
+	hd.handlePaged(w, r, time.Minute, func(offset string, limit int64, reverse bool) ([]byte, bool, error) {
+		return hd.handleAccountSignerNFTsInGroup(address, role, signed, path, offset, limit, reverse)
+	}, stringSignedQuery(signed))
+}
+
+func (hd *Handlers) handleAccountSignerNFTsInGroup(
+	address base.Address,
+	role string,
+	signed string,
+	path string,
+	offset string,
+	l int64,
+	reverse bool,
+) ([]byte, bool, error) {
+	limit := l
+	if l < 0 {
+		limit = hd.itemsLimiter("account-" + role)
+	}
+
+	var vas []Hal
+	var nextoffset string
+	if err := hd.database.NFTsBySigner(
+		address, role, signed, reverse, offset, limit,
+		func(id string, va NFTValue) (bool, error) {
+			hal, err := hd.buildNFTHal(va)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+			nextoffset = id
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, err
+	} else if len(vas) < 1 {
+		return nil, false, util.NotFoundError.Errorf("nfts not found")
+	}
+
+	i, err := hd.buildAccountPagedHal(path, address, vas, offset, nextoffset, reverse, stringSignedQuery(signed))
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.enc.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func stringSignedQuery(signed string) string {
+	if len(signed) < 1 {
+		return ""
+	}
+
+	return "signed=" + signed
+}
+
+func (hd *Handlers) buildAccountPagedHal(
+	path string,
+	address base.Address,
+	vas []Hal,
+	offset string,
+	nextoffset string,
+	reverse bool,
+	queries ...string,
+) (Hal, error) {
+	baseSelf, err := hd.combineURL(path, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range queries {
+		baseSelf = addQueryValue(baseSelf, queries[i])
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(baseSelf, stringOffsetQuery(offset))
+	}
+	if reverse {
+		self = addQueryValue(baseSelf, stringBoolQuery("reverse", reverse))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	if len(nextoffset) > 0 {
+		next := addQueryValue(baseSelf, stringOffsetQuery(nextoffset))
+		if reverse {
+			next = addQueryValue(next, stringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink("reverse", NewHalLink(addQueryValue(baseSelf, stringBoolQuery("reverse", !reverse)), nil))
+
+	return hal, nil
+}
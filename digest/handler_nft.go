@@ -2,17 +2,26 @@ package digest
 
 import (
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/ProtoconNet/mitum-nft/nft"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util"
 )
 
 func (hd *Handlers) handleNFT(w http.ResponseWriter, r *http.Request) {
-	cachekey := CacheKeyPath(r)
+	height, err := parseHeightQuery(r.URL.Query().Get("height"))
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	cachekey := CacheKey(r.URL.Path, stringHeightQuery(height))
 	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
 		return
 	}
@@ -31,9 +40,16 @@ func (hd *Handlers) handleNFT(w http.ResponseWriter, r *http.Request) {
 
 		return
 	}
-	id = s
+	nid, err := nft.ParseNFTID(s)
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+	id = nid.String()
+
 	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		return hd.handleNFTInGroup(id)
+		return hd.handleNFTInGroup(id, height)
 	}); err != nil {
 		HTTP2HandleError(w, err)
 	} else {
@@ -44,8 +60,10 @@ func (hd *Handlers) handleNFT(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (hd *Handlers) handleNFTInGroup(id string) (interface{}, error) {
-	switch va, _, _, err := hd.database.NFT(id); {
+// handleNFTInGroup returns the latest nft, or the nft as of height if height
+// is not base.NilHeight.
+func (hd *Handlers) handleNFTInGroup(id string, height base.Height) (interface{}, error) {
+	switch va, err := hd.nftAt(id, height); {
 	case err != nil:
 		return nil, err
 	default:
@@ -57,6 +75,23 @@ func (hd *Handlers) handleNFTInGroup(id string) (interface{}, error) {
 	}
 }
 
+func (hd *Handlers) nftAt(id string, height base.Height) (NFTValue, error) {
+	if height <= base.NilHeight {
+		va, _, _, err := hd.database.NFT(id)
+
+		return va, err
+	}
+
+	switch va, found, err := hd.database.NFTAt(id, height); {
+	case err != nil:
+		return NFTValue{}, err
+	case !found:
+		return NFTValue{}, util.NotFoundError.Errorf("nft not found at height, %d", height)
+	default:
+		return va, nil
+	}
+}
+
 func (hd *Handlers) buildNFTHal(va NFTValue) (Hal, error) {
 	hinted := va.nft.ID().String()
 	h, err := hd.combineURL(HandlerPathNFT, "id", hinted)
@@ -64,7 +99,14 @@ func (hd *Handlers) buildNFTHal(va NFTValue) (Hal, error) {
 		return nil, err
 	}
 
-	hal := NewBaseHal(va, NewHalLink(h, nil))
+	var hal Hal
+	hal = NewBaseHal(va, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathNFTHistory, "id", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("history", NewHalLink(h, nil))
 
 	return hal, nil
 }
@@ -147,9 +189,17 @@ func (hd *Handlers) handleCollectionNFTs(w http.ResponseWriter, r *http.Request)
 	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
 	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
 
+	height, err := parseHeightQuery(r.URL.Query().Get("height"))
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
 	cachekey := CacheKey(
 		r.URL.Path, stringOffsetQuery(offset),
 		stringBoolQuery("reverse", reverse),
+		stringHeightQuery(height),
 	)
 
 	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
@@ -157,7 +207,7 @@ func (hd *Handlers) handleCollectionNFTs(w http.ResponseWriter, r *http.Request)
 	}
 
 	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		i, filled, err := hd.handleCollectionNFTsInGroup(symbol, offset, reverse, limit)
+		i, filled, err := hd.handleCollectionNFTsInGroup(symbol, height, offset, reverse, limit)
 
 		return []interface{}{i, filled}, err
 	})
@@ -191,6 +241,7 @@ func (hd *Handlers) handleCollectionNFTs(w http.ResponseWriter, r *http.Request)
 
 func (hd *Handlers) handleCollectionNFTsInGroup(
 	symbol string,
+	height base.Height,
 	offset string,
 	reverse bool,
 	l int64,
@@ -203,24 +254,324 @@ func (hd *Handlers) handleCollectionNFTsInGroup(
 	}
 
 	var vas []Hal
-	if err := hd.database.NFTsByCollection(
-		symbol, reverse, offset, limit,
-		func(_ string, va NFTValue) (bool, error) {
-			hal, err := hd.buildNFTHal(va)
+	callback := func(_ string, va NFTValue) (bool, error) {
+		hal, err := hd.buildNFTHal(va)
+		if err != nil {
+			return false, err
+		}
+		vas = append(vas, hal)
+
+		return true, nil
+	}
+
+	var err error
+	if height > base.NilHeight {
+		err = hd.database.NFTsByCollectionAt(symbol, height, reverse, offset, limit, callback)
+	} else {
+		err = hd.database.NFTsByCollection(symbol, reverse, offset, limit, callback)
+	}
+
+	if err != nil {
+		return nil, false, err
+	} else if len(vas) < 1 {
+		return nil, false, util.NotFoundError.Errorf("nfts not found")
+	}
+
+	i, err := hd.buildCollectionNFTsHal(symbol, height, vas, offset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.enc.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) handleNFTHistory(w http.ResponseWriter, r *http.Request) {
+	s := strings.TrimSpace(mux.Vars(r)["id"])
+	if len(s) < 1 {
+		HTTP2ProblemWithError(w, errors.Errorf("empty id"), http.StatusBadRequest)
+
+		return
+	}
+
+	nid, err := nft.ParseNFTID(s)
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+	id := nid.String()
+
+	limit := parseLimitQuery(r.URL.Query().Get("limit"))
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
+
+	cachekey := CacheKey(
+		r.URL.Path, stringOffsetQuery(offset),
+		stringBoolQuery("reverse", reverse),
+	)
+
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleNFTHistoryInGroup(id, offset, reverse, limit)
+
+		return []interface{}{i, filled}, err
+	})
+
+	if err != nil {
+		hd.Log().Error().Err(err).Str("id", id).Msg("failed to get nft history")
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			expire = time.Minute
+		}
+
+		HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
+func (hd *Handlers) handleNFTHistoryInGroup(
+	id string,
+	offset string,
+	reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("nft-history")
+	} else {
+		limit = l
+	}
+
+	var nfts []NFTValue
+	var facts [][]string
+	if err := hd.database.NFTHistory(
+		id, reverse, offset, limit,
+		func(va NFTValue, fcs []string) (bool, error) {
+			nfts = append(nfts, va)
+			facts = append(facts, fcs)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, err
+	} else if len(nfts) < 1 {
+		return nil, false, util.NotFoundError.Errorf("nft history not found")
+	}
+
+	first := nfts[0]
+	if reverse {
+		first = nfts[len(nfts)-1]
+	}
+
+	var previous *nft.NFT
+	switch va, found, err := hd.database.NFTBefore(id, first.Height()); {
+	case err != nil:
+		return nil, false, err
+	case found:
+		n := va.NFT()
+		previous = &n
+	}
+
+	vas := buildNFTHistory(previous, nfts, facts, reverse)
+
+	h, err := hd.combineURL(HandlerPathNFT, "id", id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hals := make([]Hal, len(vas))
+	for i := range vas {
+		hals[i] = NewBaseHal(vas[i], NewHalLink(h, nil))
+	}
+
+	i, err := hd.buildNFTHistoryHal(id, hals, offset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.enc.Marshal(i)
+	return b, int64(len(hals)) == limit, err
+}
+
+// buildNFTHistory compares each nft with the one of the previous height. nfts
+// are ordered by height, descending if reverse; previous is the nft before
+// them.
+func buildNFTHistory(previous *nft.NFT, nfts []NFTValue, facts [][]string, reverse bool) []NFTHistoryValue {
+	vas := make([]NFTHistoryValue, len(nfts))
+
+	for i := range nfts {
+		j := i
+		if reverse {
+			j = len(nfts) - 1 - i
+		}
+
+		n := nfts[j].NFT()
+		vas[j] = NewNFTHistoryValue(n, nfts[j].Height(), facts[j], compareNFT(previous, n))
+		previous = &n
+	}
+
+	return vas
+}
+
+func (hd *Handlers) buildNFTHistoryHal(
+	id string,
+	vas []Hal,
+	offset string,
+	reverse bool,
+) (Hal, error) {
+	baseSelf, err := hd.combineURL(HandlerPathNFTHistory, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(baseSelf, stringOffsetQuery(offset))
+	}
+	if reverse {
+		self = addQueryValue(baseSelf, stringBoolQuery("reverse", reverse))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathNFT, "id", id)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("nft", NewHalLink(h, nil))
+
+	if len(vas) > 0 {
+		va := vas[len(vas)-1].Interface().(NFTHistoryValue)
+
+		next := addQueryValue(baseSelf, stringOffsetQuery(va.Height().String()))
+		if reverse {
+			next = addQueryValue(next, stringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink("reverse", NewHalLink(addQueryValue(baseSelf, stringBoolQuery("reverse", !reverse)), nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleNFTCollections(w http.ResponseWriter, r *http.Request) {
+	limit := parseLimitQuery(r.URL.Query().Get("limit"))
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
+	filter := NFTCollectionsFilter{
+		Parent:  parseStringQuery(r.URL.Query().Get("parent")),
+		Creator: parseStringQuery(r.URL.Query().Get("creator")),
+		Active:  parseStringQuery(r.URL.Query().Get("active")),
+		Name:    parseStringQuery(r.URL.Query().Get("name")),
+	}
+
+	switch filter.Active {
+	case "", "0", "1":
+	default:
+		HTTP2ProblemWithError(w, errors.Errorf("invalid active query, %q", filter.Active), http.StatusBadRequest)
+
+		return
+	}
+
+	cachekey := CacheKey(
+		r.URL.Path, stringOffsetQuery(offset),
+		stringBoolQuery("reverse", reverse),
+		stringNFTCollectionsFilterQuery(filter),
+	)
+
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleNFTCollectionsInGroup(filter, offset, reverse, limit)
+
+		return []interface{}{i, filled}, err
+	})
+
+	if err != nil {
+		hd.Log().Error().Err(err).Msg("failed to get nft collections")
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			expire = time.Minute
+		}
+
+		HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
+func (hd *Handlers) handleNFTCollectionsInGroup(
+	filter NFTCollectionsFilter,
+	offset string,
+	reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("nft-collections")
+	} else {
+		limit = l
+	}
+
+	var vas []Hal
+	var nextoffset string
+	if err := hd.database.NFTCollections(
+		filter, reverse, offset, limit,
+		func(symbol string, va nft.Design) (bool, error) {
+			hal, err := hd.buildNFTCollectionHal(va)
 			if err != nil {
 				return false, err
 			}
 			vas = append(vas, hal)
+			nextoffset = symbol
 
 			return true, nil
 		},
 	); err != nil {
 		return nil, false, err
 	} else if len(vas) < 1 {
-		return nil, false, util.NotFoundError.Errorf("nfts not found")
+		return nil, false, util.NotFoundError.Errorf("nft collections not found")
 	}
 
-	i, err := hd.buildCollectionNFTsHal(symbol, vas, offset, reverse)
+	i, err := hd.buildNFTCollectionsHal(filter, vas, offset, nextoffset, reverse)
 	if err != nil {
 		return nil, false, err
 	}
@@ -228,3 +579,59 @@ func (hd *Handlers) handleCollectionNFTsInGroup(
 	b, err := hd.enc.Marshal(i)
 	return b, int64(len(vas)) == limit, err
 }
+
+func (hd *Handlers) buildNFTCollectionsHal(
+	filter NFTCollectionsFilter,
+	vas []Hal,
+	offset string,
+	nextoffset string,
+	reverse bool,
+) (Hal, error) {
+	h, err := hd.combineURL(HandlerPathNFTCollections)
+	if err != nil {
+		return nil, err
+	}
+	baseSelf := addQueryValue(h, stringNFTCollectionsFilterQuery(filter))
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(self, stringOffsetQuery(offset))
+	}
+	if reverse {
+		self = addQueryValue(self, stringBoolQuery("reverse", reverse))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	if len(nextoffset) > 0 {
+		next := addQueryValue(baseSelf, stringOffsetQuery(nextoffset))
+		if reverse {
+			next = addQueryValue(next, stringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink("reverse", NewHalLink(addQueryValue(baseSelf, stringBoolQuery("reverse", !reverse)), nil))
+
+	return hal, nil
+}
+
+func stringNFTCollectionsFilterQuery(filter NFTCollectionsFilter) string {
+	q := url.Values{}
+	if len(filter.Parent) > 0 {
+		q.Set("parent", filter.Parent)
+	}
+	if len(filter.Creator) > 0 {
+		q.Set("creator", filter.Creator)
+	}
+	if len(filter.Active) > 0 {
+		q.Set("active", filter.Active)
+	}
+	if len(filter.Name) > 0 {
+		q.Set("name", filter.Name)
+	}
+
+	return q.Encode()
+}
@@ -0,0 +1,233 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (hd *Handlers) handleNFTOperations(w http.ResponseWriter, r *http.Request) {
+	s := strings.TrimSpace(mux.Vars(r)["id"])
+	if len(s) < 1 {
+		HTTP2ProblemWithError(w, errors.Errorf("empty id"), http.StatusBadRequest)
+
+		return
+	}
+
+	id, err := nft.ParseNFTID(s)
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	hd.handlePaged(w, r, time.Hour*30, func(offset string, limit int64, reverse bool) ([]byte, bool, error) {
+		return hd.handleNFTOperationsInGroup(id, offset, limit, reverse)
+	})
+}
+
+func (hd *Handlers) handleNFTOperationsInGroup(
+	id nft.NFTID,
+	offset string,
+	l int64,
+	reverse bool,
+) ([]byte, bool, error) {
+	limit := l
+	if l < 0 {
+		limit = hd.itemsLimiter("nft-operations")
+	}
+
+	var vas []Hal
+	if err := hd.database.OperationsByNFT(
+		id, true, reverse, offset, limit,
+		func(_ valuehash.Hash, va OperationValue) (bool, error) {
+			hal, err := hd.buildOperationHal(va)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, err
+	} else if len(vas) < 1 {
+		return nil, false, util.NotFoundError.Errorf("operations not found")
+	}
+
+	i, err := hd.buildNFTOperationsHal(
+		HandlerPathNFTOperations, "id", id.String(),
+		"nft", HandlerPathNFT,
+		vas, offset, reverse,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.enc.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) handleNFTCollectionOperations(w http.ResponseWriter, r *http.Request) {
+	s := strings.TrimSpace(mux.Vars(r)["symbol"])
+	if len(s) < 1 {
+		HTTP2ProblemWithError(w, errors.Errorf("empty symbol"), http.StatusBadRequest)
+
+		return
+	}
+
+	symbol := extensioncurrency.ContractID(s)
+	if err := symbol.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	hd.handlePaged(w, r, time.Hour*30, func(offset string, limit int64, reverse bool) ([]byte, bool, error) {
+		return hd.handleNFTCollectionOperationsInGroup(symbol, offset, limit, reverse)
+	})
+}
+
+func (hd *Handlers) handleNFTCollectionOperationsInGroup(
+	symbol extensioncurrency.ContractID,
+	offset string,
+	l int64,
+	reverse bool,
+) ([]byte, bool, error) {
+	limit := l
+	if l < 0 {
+		limit = hd.itemsLimiter("nft-collection-operations")
+	}
+
+	var vas []Hal
+	if err := hd.database.OperationsByCollection(
+		symbol, true, reverse, offset, limit,
+		func(_ valuehash.Hash, va OperationValue) (bool, error) {
+			hal, err := hd.buildOperationHal(va)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, err
+	} else if len(vas) < 1 {
+		return nil, false, util.NotFoundError.Errorf("operations not found")
+	}
+
+	i, err := hd.buildNFTOperationsHal(
+		HandlerPathNFTCollectionOperations, "symbol", symbol.String(),
+		"collection", HandlerPathNFTCollection,
+		vas, offset, reverse,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.enc.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+// handlePaged serves the items paged by offset and reverse like the account
+// operations. The filled page with offset is cached until expire. queries are
+// the other queries of the items for cache key.
+func (hd *Handlers) handlePaged(
+	w http.ResponseWriter,
+	r *http.Request,
+	expire time.Duration,
+	f func(offset string, limit int64, reverse bool) ([]byte, bool, error),
+	queries ...string,
+) {
+	limit := parseLimitQuery(r.URL.Query().Get("limit"))
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
+
+	cachekey := CacheKey(r.URL.Path, append([]string{stringOffsetQuery(offset), stringBoolQuery("reverse", reverse)}, queries...)...)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := f(offset, limit, reverse)
+
+		return []interface{}{i, filled}, err
+	})
+	if err != nil {
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+
+	if !shared {
+		e := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			e = expire
+		}
+
+		HTTP2WriteCache(w, cachekey, e)
+	}
+}
+
+func (hd *Handlers) buildNFTOperationsHal(
+	path, key, value string,
+	linkName, linkPath string,
+	vas []Hal,
+	offset string,
+	reverse bool,
+) (Hal, error) {
+	baseSelf, err := hd.combineURL(path, key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(baseSelf, stringOffsetQuery(offset))
+	}
+	if reverse {
+		self = addQueryValue(baseSelf, stringBoolQuery("reverse", reverse))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(linkPath, key, value)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink(linkName, NewHalLink(h, nil))
+
+	if len(vas) > 0 {
+		va := vas[len(vas)-1].Interface().(OperationValue)
+
+		next := addQueryValue(baseSelf, stringOffsetQuery(buildOffset(va.Height(), va.Index())))
+		if reverse {
+			next = addQueryValue(next, stringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink("reverse", NewHalLink(addQueryValue(baseSelf, stringBoolQuery("reverse", !reverse)), nil))
+
+	return hal, nil
+}
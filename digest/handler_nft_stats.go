@@ -0,0 +1,168 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/util"
+)
+
+func (hd *Handlers) handleNFTCollectionStats(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	symbol := strings.TrimSpace(mux.Vars(r)["symbol"])
+	if len(symbol) < 1 {
+		HTTP2ProblemWithError(w, errors.Errorf("empty symbol"), http.StatusBadRequest)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleNFTCollectionStatsInGroup(symbol)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleNFTCollectionStatsInGroup(symbol string) ([]byte, error) {
+	va, found, err := hd.database.NFTCollectionStats(symbol)
+	switch {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, util.NotFoundError.Errorf("nft collection stats not found")
+	}
+
+	h, err := hd.combineURL(HandlerPathNFTCollectionStats, "symbol", symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(va, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathNFTCollection, "symbol", symbol)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("collection", NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathNFTCollectionHolders, "symbol", symbol)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("holders", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
+func (hd *Handlers) handleNFTCollectionHolders(w http.ResponseWriter, r *http.Request) {
+	symbol := strings.TrimSpace(mux.Vars(r)["symbol"])
+	if len(symbol) < 1 {
+		HTTP2ProblemWithError(w, errors.Errorf("empty symbol"), http.StatusBadRequest)
+
+		return
+	}
+
+	limit := parseLimitQuery(r.URL.Query().Get("limit"))
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+
+	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset))
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleNFTCollectionHoldersInGroup(symbol, offset, limit)
+
+		return []interface{}{i, filled}, err
+	})
+	if err != nil {
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			expire = time.Minute
+		}
+
+		HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
+func (hd *Handlers) handleNFTCollectionHoldersInGroup(
+	symbol string,
+	offset string,
+	l int64,
+) ([]byte, bool, error) {
+	limit := l
+	if l < 0 {
+		limit = hd.itemsLimiter("nft-collection-holders")
+	}
+
+	var vas []Hal
+	var nextoffset string
+	if err := hd.database.NFTHolders(
+		symbol, offset, limit,
+		func(o string, va NFTHolderValue) (bool, error) {
+			h, err := hd.combineURL(HandlerPathAccountNFTs, "address", va.Owner().String())
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, NewBaseHal(va, NewHalLink(addQueryValue(h, stringCollectionQuery(symbol)), nil)))
+			nextoffset = o
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, err
+	} else if len(vas) < 1 {
+		return nil, false, util.NotFoundError.Errorf("nft holders not found")
+	}
+
+	self, err := hd.combineURL(HandlerPathNFTCollectionHolders, "symbol", symbol)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var hal Hal
+	if len(offset) > 0 {
+		hal = NewBaseHal(vas, NewHalLink(addQueryValue(self, stringOffsetQuery(offset)), nil))
+	} else {
+		hal = NewBaseHal(vas, NewHalLink(self, nil))
+	}
+
+	h, err := hd.combineURL(HandlerPathNFTCollectionStats, "symbol", symbol)
+	if err != nil {
+		return nil, false, err
+	}
+	hal = hal.AddLink("stats", NewHalLink(h, nil))
+	hal = hal.AddLink("next", NewHalLink(addQueryValue(self, stringOffsetQuery(nextoffset)), nil))
+
+	b, err := hd.enc.Marshal(hal)
+	return b, int64(len(vas)) == limit, err
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/spikeekips/mitum-currency/currency"
@@ -18,6 +19,15 @@ var factTypesByHint = map[string]hint.Hinter{
 	"key-updater":       currency.KeyUpdaterHinter,
 	"transfers":         currency.TransfersHinter,
 	"currency-register": currency.CurrencyRegisterHinter,
+
+	"collection-register":       collection.CollectionRegisterHinter,
+	"collection-policy-updater": collection.CollectionPolicyUpdaterHinter,
+	"mint":                      collection.MintHinter,
+	"nft-transfer":              collection.TransferHinter,
+	"burn":                      collection.BurnHinter,
+	"approve":                   collection.ApproveHinter,
+	"delegate":                  collection.DelegateHinter,
+	"sign":                      collection.SignHinter,
 }
 
 func (hd *Handlers) handleOperationBuild(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,121 @@
+package digest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+func (hd *Handlers) SetOperationProcessor(opr *collection.OperationProcessor) *Handlers {
+	hd.opr = opr
+
+	return hd
+}
+
+func (hd *Handlers) handleSimulate(w http.ResponseWriter, r *http.Request) {
+	if hd.opr == nil || hd.database == nil {
+		HTTP2NotSupported(w, nil)
+
+		return
+	}
+
+	body := &bytes.Buffer{}
+	if _, err := io.Copy(body, r.Body); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	}
+
+	var ops []operation.Operation
+	var v []json.RawMessage
+	if err := jsonenc.Unmarshal(body.Bytes(), &v); err != nil {
+		if hinter, err := hd.enc.Decode(body.Bytes()); err != nil {
+			HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		} else if i, err := hd.simulateItem(hinter); err != nil {
+			HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		} else {
+			ops = i
+		}
+	} else if i, err := hd.simulateOperations(v); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else {
+		ops = i
+	}
+
+	va, err := Simulate(hd.opr, hd.database.mitum, ops)
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	}
+
+	var hal Hal = NewBaseHal(va, HalLink{})
+	for i := range ops {
+		h, err := hd.combineURL(HandlerPathOperation, "hash", ops[i].Fact().Hash().String())
+		if err != nil {
+			HTTP2ProblemWithError(w, err, http.StatusInternalServerError)
+
+			return
+		}
+		hal = hal.AddLink(fmt.Sprintf("operation:%d", i), NewHalLink(h, nil))
+	}
+
+	HTTP2WriteHal(hd.enc, w, hal, http.StatusOK)
+}
+
+// simulateItem returns the operations of v. The operations are checked by
+// IsValid including the fact signatures in the same way whether they come in
+// seal or not; the seal itself is not checked, because it is not broadcasted.
+func (hd *Handlers) simulateItem(v interface{}) ([]operation.Operation, error) {
+	var ops []operation.Operation
+	switch t := v.(type) {
+	case operation.Seal:
+		ops = t.Operations()
+	case operation.Operation:
+		ops = []operation.Operation{t}
+	default:
+		return nil, errors.Errorf("unsupported message type, %T", v)
+	}
+
+	if len(ops) < 1 {
+		return nil, errors.Errorf("empty operations")
+	}
+
+	for i := range ops {
+		if err := ops[i].IsValid(hd.networkID); err != nil {
+			return nil, err
+		}
+	}
+
+	return ops, nil
+}
+
+func (hd *Handlers) simulateOperations(v []json.RawMessage) ([]operation.Operation, error) {
+	ops := make([]operation.Operation, len(v))
+	for i := range v {
+		if hinter, err := hd.enc.Decode(v[i]); err != nil {
+			return nil, err
+		} else if op, ok := hinter.(operation.Operation); !ok {
+			return nil, errors.Errorf("unsupported message type, %T", hinter)
+		} else if err := op.IsValid(hd.networkID); err != nil {
+			return nil, err
+		} else {
+			ops[i] = op
+		}
+	}
+
+	return ops, nil
+}
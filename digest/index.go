@@ -54,6 +54,16 @@ var operationIndexModels = []mongo.IndexModel{
 		Options: options.Index().
 			SetName("mitum_digest_account_operation"),
 	},
+	{
+		Keys: bson.D{bson.E{Key: "nftids", Value: 1}, bson.E{Key: "height", Value: 1}, bson.E{Key: "index", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_operation"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "collections", Value: 1}, bson.E{Key: "height", Value: 1}, bson.E{Key: "index", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_collection_operation"),
+	},
 	{
 		Keys: bson.D{bson.E{Key: "height", Value: 1}, bson.E{Key: "index", Value: 1}},
 		Options: options.Index().
@@ -66,8 +76,128 @@ var operationIndexModels = []mongo.IndexModel{
 	},
 }
 
+var nftIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "nftid", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "collection", Value: 1}, bson.E{Key: "nftid", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_collection_height"),
+	},
+}
+
+var nftLatestIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "nftid", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_latest").
+			SetUnique(true),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "owner", Value: 1}, bson.E{Key: "nftid", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_owner"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "collection", Value: 1}, bson.E{Key: "nftid", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_latest_collection"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "approved", Value: 1}, bson.E{Key: "nftid", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_approved"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "creators", Value: 1}, bson.E{Key: "nftid", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_creators"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "copyrighters", Value: 1}, bson.E{Key: "nftid", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_copyrighters"),
+	},
+}
+
+var nftCollectionIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "symbol", Value: 1}, bson.E{Key: "height", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_collection"),
+	},
+}
+
+var nftCollectionLatestIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "symbol", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_collection_latest").
+			SetUnique(true),
+	},
+}
+
+var nftAgentIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "statekey", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_agent"),
+	},
+}
+
+var nftAgentLatestIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "statekey", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_agent_latest").
+			SetUnique(true),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "collectionid", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_agent_address"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "agents", Value: 1}, bson.E{Key: "statekey", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_delegator"),
+	},
+}
+
+var nftStatsIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "collection", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_stats"),
+	},
+}
+
+var nftHolderIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "holderkey", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_holder_key"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "collection", Value: 1}, bson.E{Key: "holderkey", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_nft_holder"),
+	},
+}
+
 var defaultIndexes = map[string] /* collection */ []mongo.IndexModel{
-	defaultColNameAccount:   accountIndexModels,
-	defaultColNameBalance:   balanceIndexModels,
-	defaultColNameOperation: operationIndexModels,
+	defaultColNameAccount:             accountIndexModels,
+	defaultColNameBalance:             balanceIndexModels,
+	defaultColNameOperation:           operationIndexModels,
+	defaultColNameNFTCollection:       nftCollectionIndexModels,
+	defaultColNameNFT:                 nftIndexModels,
+	defaultColNameNFTAgent:            nftAgentIndexModels,
+	defaultColNameNFTStats:            nftStatsIndexModels,
+	defaultColNameNFTHolder:           nftHolderIndexModels,
+	defaultColNameNFTCollectionLatest: nftCollectionLatestIndexModels,
+	defaultColNameNFTLatest:           nftLatestIndexModels,
+	defaultColNameNFTAgentLatest:      nftAgentLatestIndexModels,
 }
@@ -0,0 +1,64 @@
+package digest
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type testNFTsAt struct {
+	suite.Suite
+}
+
+func (t *testNFTsAt) TestPipeline() {
+	pipeline := buildNFTsAtPipeline(
+		bson.M{"collection": "ABC"},
+		bson.M{"owner": "owner"},
+		base.Height(33),
+		false,
+		"ABC-00001",
+		10,
+	)
+
+	t.Equal(7, len(pipeline))
+
+	match := pipeline[0][0]
+	t.Equal("$match", match.Key)
+	t.Equal(bson.M{
+		"height":     bson.M{"$lte": base.Height(33)},
+		"collection": "ABC",
+		"nftid":      bson.M{"$gt": "ABC-00001"},
+	}, match.Value)
+
+	t.Equal("$group", pipeline[2][0].Key)
+	t.Equal(bson.D{{"$match", bson.M{"owner": "owner"}}}, pipeline[4])
+	t.Equal(bson.D{{"$limit", int64(10)}}, pipeline[6])
+}
+
+func (t *testNFTsAt) TestPipelineWithoutLatest() {
+	pipeline := buildNFTsAtPipeline(bson.M{"collection": "ABC"}, nil, base.Height(33), true, "", 0)
+
+	t.Equal(5, len(pipeline))
+	t.Equal(bson.D{{"$sort", bson.D{{"nftid", -1}}}}, pipeline[4])
+}
+
+func (t *testNFTsAt) TestHeightQuery() {
+	h, err := parseHeightQuery("")
+	t.NoError(err)
+	t.Equal(base.NilHeight, h)
+	t.Equal("", stringHeightQuery(h))
+
+	h, err = parseHeightQuery("33")
+	t.NoError(err)
+	t.Equal(base.Height(33), h)
+	t.Equal("height=33", stringHeightQuery(h))
+
+	_, err = parseHeightQuery("033")
+	t.Error(err)
+}
+
+func TestNFTsAt(t *testing.T) {
+	suite.Run(t, new(testNFTsAt))
+}
@@ -0,0 +1,41 @@
+package digest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type testNFTCollections struct {
+	suite.Suite
+}
+
+func (t *testNFTCollections) TestFilter() {
+	filter := buildNFTCollectionsFilter(NFTCollectionsFilter{}, "", false)
+	t.Empty(filter)
+
+	filter = buildNFTCollectionsFilter(NFTCollectionsFilter{
+		Parent:  "parent",
+		Creator: "creator",
+		Active:  "0",
+		Name:    "cool.",
+	}, "ABC", true)
+	t.Equal("parent", filter["parent"])
+	t.Equal("creator", filter["creator"])
+	t.Equal(false, filter["active"])
+	t.Equal(bson.M{"$regex": `^cool\.`}, filter["name"])
+	t.Equal(bson.M{"$lt": "ABC"}, filter["symbol"])
+}
+
+func (t *testNFTCollections) TestFilterQuery() {
+	t.Equal("", stringNFTCollectionsFilterQuery(NFTCollectionsFilter{}))
+	t.Equal(
+		"active=1&name=cool+cat",
+		stringNFTCollectionsFilterQuery(NFTCollectionsFilter{Active: "1", Name: "cool cat"}),
+	)
+}
+
+func TestNFTCollections(t *testing.T) {
+	suite.Run(t, new(testNFTCollections))
+}
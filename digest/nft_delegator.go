@@ -0,0 +1,44 @@
+package digest
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+var (
+	NFTDelegatorValueType = hint.Type("mitum-nft-delegator-value")
+	NFTDelegatorValueHint = hint.NewHint(NFTDelegatorValueType, "v0.0.1")
+)
+
+// NFTDelegatorValue is the owner and the agents of the owner in the
+// collection.
+type NFTDelegatorValue struct {
+	owner  base.Address
+	agents collection.AgentBox
+	height base.Height
+}
+
+func NewNFTDelegatorValue(owner base.Address, agents collection.AgentBox, height base.Height) NFTDelegatorValue {
+	return NFTDelegatorValue{
+		owner:  owner,
+		agents: agents,
+		height: height,
+	}
+}
+
+func (NFTDelegatorValue) Hint() hint.Hint {
+	return NFTDelegatorValueHint
+}
+
+func (va NFTDelegatorValue) Owner() base.Address {
+	return va.owner
+}
+
+func (va NFTDelegatorValue) Agents() collection.AgentBox {
+	return va.agents
+}
+
+func (va NFTDelegatorValue) Height() base.Height {
+	return va.height
+}
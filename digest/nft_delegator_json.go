@@ -0,0 +1,25 @@
+package digest
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type NFTDelegatorValueJSONPacker struct {
+	jsonenc.HintedHead
+	OW base.Address                 `json:"owner"`
+	CL extensioncurrency.ContractID `json:"collection"`
+	AG []base.Address               `json:"agents"`
+	HT base.Height                  `json:"height"`
+}
+
+func (va NFTDelegatorValue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(NFTDelegatorValueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(va.Hint()),
+		OW:         va.owner,
+		CL:         va.agents.Collection(),
+		AG:         va.agents.Agents(),
+		HT:         va.height,
+	})
+}
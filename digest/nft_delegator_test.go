@@ -0,0 +1,48 @@
+package digest
+
+import (
+	"testing"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/spikeekips/mitum/base"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type testNFTDelegator struct {
+	suite.Suite
+}
+
+func (t *testNFTDelegator) TestAgentsOwner() {
+	owner := nft.NewTestAddress()
+	agents := collection.NewAgentBox(extensioncurrency.ContractID("ABC"), []base.Address{nft.NewTestAddress()})
+
+	t.Equal(owner.String(), agentsOwner(collection.StateKeyAgents(owner, agents.Collection()), agents))
+}
+
+func (t *testNFTDelegator) TestDelegatorsFilter() {
+	agent := nft.NewTestAddress()
+
+	filter := buildNFTDelegatorsFilter(agent, "", false)
+	t.Equal(bson.M{"$in": []string{agent.String()}}, filter["agents"])
+	t.NotContains(filter, "statekey")
+
+	filter = buildNFTDelegatorsFilter(agent, "key", true)
+	t.Equal(bson.M{"$lt": "key"}, filter["statekey"])
+}
+
+func (t *testNFTDelegator) TestApprovedFilter() {
+	approved := nft.NewTestAddress()
+
+	filter := buildNFTsFilterByApproved(approved, "ABC-00001", false)
+	t.Equal(approved.String(), filter["approved"])
+	t.Equal(bson.M{"$ne": approved.String()}, filter["owner"])
+	t.Equal(true, filter["active"])
+	t.Equal(bson.M{"$gt": "ABC-00001"}, filter["nftid"])
+}
+
+func TestNFTDelegator(t *testing.T) {
+	suite.Run(t, new(testNFTDelegator))
+}
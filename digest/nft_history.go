@@ -0,0 +1,91 @@
+package digest
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+var (
+	NFTHistoryValueType = hint.Type("mitum-nft-history-value")
+	NFTHistoryValueHint = hint.NewHint(NFTHistoryValueType, "v0.0.1")
+)
+
+// NFTChange is the change of a field of nft, "owner", "approved", "active",
+// "creators" or "copyrighters". Previous is nil when nft is minted.
+type NFTChange struct {
+	Field    string      `json:"field"`
+	Previous interface{} `json:"previous"`
+	Next     interface{} `json:"next"`
+}
+
+// NFTHistoryValue is the changes of nft at height by the operation facts.
+type NFTHistoryValue struct {
+	nft     nft.NFT
+	height  base.Height
+	facts   []string
+	changes []NFTChange
+}
+
+func NewNFTHistoryValue(n nft.NFT, height base.Height, facts []string, changes []NFTChange) NFTHistoryValue {
+	return NFTHistoryValue{
+		nft:     n,
+		height:  height,
+		facts:   facts,
+		changes: changes,
+	}
+}
+
+func (NFTHistoryValue) Hint() hint.Hint {
+	return NFTHistoryValueHint
+}
+
+func (va NFTHistoryValue) NFT() nft.NFT {
+	return va.nft
+}
+
+func (va NFTHistoryValue) Height() base.Height {
+	return va.height
+}
+
+func (va NFTHistoryValue) Facts() []string {
+	return va.facts
+}
+
+func (va NFTHistoryValue) Changes() []NFTChange {
+	return va.changes
+}
+
+// compareNFT returns the changes from previous to next nft. previous is nil
+// for the minted nft.
+func compareNFT(previous *nft.NFT, next nft.NFT) []NFTChange {
+	if previous == nil {
+		return []NFTChange{
+			{Field: "owner", Previous: nil, Next: next.Owner()},
+			{Field: "active", Previous: nil, Next: next.Active()},
+		}
+	}
+
+	var changes []NFTChange
+	if !previous.Owner().Equal(next.Owner()) {
+		changes = append(changes, NFTChange{Field: "owner", Previous: previous.Owner(), Next: next.Owner()})
+	}
+
+	if !previous.Approved().Equal(next.Approved()) {
+		changes = append(changes, NFTChange{Field: "approved", Previous: previous.Approved(), Next: next.Approved()})
+	}
+
+	if previous.Active() != next.Active() {
+		changes = append(changes, NFTChange{Field: "active", Previous: previous.Active(), Next: next.Active()})
+	}
+
+	if !previous.Creators().Equal(next.Creators()) {
+		changes = append(changes, NFTChange{Field: "creators", Previous: previous.Creators(), Next: next.Creators()})
+	}
+
+	if !previous.Copyrighters().Equal(next.Copyrighters()) {
+		changes = append(changes, NFTChange{Field: "copyrighters", Previous: previous.Copyrighters(), Next: next.Copyrighters()})
+	}
+
+	return changes
+}
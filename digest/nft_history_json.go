@@ -0,0 +1,25 @@
+package digest
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type NFTHistoryValueJSONPacker struct {
+	jsonenc.HintedHead
+	ID nft.NFTID   `json:"nftid"`
+	HT base.Height `json:"height"`
+	FC []string    `json:"facts"`
+	CH []NFTChange `json:"changes"`
+}
+
+func (va NFTHistoryValue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(NFTHistoryValueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(va.Hint()),
+		ID:         va.nft.ID(),
+		HT:         va.height,
+		FC:         va.facts,
+		CH:         va.changes,
+	})
+}
@@ -0,0 +1,75 @@
+package digest
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/spikeekips/mitum/base"
+	"github.com/stretchr/testify/suite"
+)
+
+type testNFTHistory struct {
+	suite.Suite
+}
+
+func (t *testNFTHistory) newNFT(active bool, owner, approved base.Address) nft.NFT {
+	return nft.NewNFT(nft.NewTestNFTID(1), active, owner, "", "https://localhost:5000/nft", approved, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+}
+
+func (t *testNFTHistory) TestCompare() {
+	owner := nft.NewTestAddress()
+	receiver := nft.NewTestAddress()
+
+	minted := t.newNFT(true, owner, owner)
+
+	changes := compareNFT(nil, minted)
+	t.Equal(2, len(changes))
+	t.Equal("owner", changes[0].Field)
+	t.Nil(changes[0].Previous)
+
+	transferred := t.newNFT(true, receiver, receiver)
+
+	changes = compareNFT(&minted, transferred)
+	t.Equal(2, len(changes))
+	t.Equal("owner", changes[0].Field)
+	t.Equal(owner, changes[0].Previous)
+	t.Equal(receiver, changes[0].Next)
+	t.Equal("approved", changes[1].Field)
+
+	burned := t.newNFT(false, receiver, receiver)
+
+	changes = compareNFT(&transferred, burned)
+	t.Equal(1, len(changes))
+	t.Equal("active", changes[0].Field)
+	t.Equal(false, changes[0].Next)
+}
+
+func (t *testNFTHistory) TestBuild() {
+	owner := nft.NewTestAddress()
+	receiver := nft.NewTestAddress()
+
+	nfts := []NFTValue{
+		NewNFTValue(t.newNFT(true, owner, owner), base.Height(3)),
+		NewNFTValue(t.newNFT(true, receiver, receiver), base.Height(5)),
+	}
+	facts := [][]string{{"fact0"}, {"fact1"}}
+
+	vas := buildNFTHistory(nil, nfts, facts, false)
+	t.Equal(2, len(vas))
+	t.Nil(vas[0].Changes()[0].Previous)
+	t.Equal(owner, vas[1].Changes()[0].Previous)
+	t.Equal([]string{"fact1"}, vas[1].Facts())
+
+	// reversed, the previous nft is the next in order
+	rnfts := []NFTValue{nfts[1], nfts[0]}
+	rfacts := [][]string{facts[1], facts[0]}
+
+	vas = buildNFTHistory(nil, rnfts, rfacts, true)
+	t.Equal(base.Height(5), vas[0].Height())
+	t.Equal(owner, vas[0].Changes()[0].Previous)
+	t.Nil(vas[1].Changes()[0].Previous)
+}
+
+func TestNFTHistory(t *testing.T) {
+	suite.Run(t, new(testNFTHistory))
+}
@@ -0,0 +1,169 @@
+package digest
+
+import (
+	"sort"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+var (
+	NFTCollectionStatsValueType = hint.Type("mitum-nft-collection-stats-value")
+	NFTCollectionStatsValueHint = hint.NewHint(NFTCollectionStatsValueType, "v0.0.1")
+	NFTHolderValueType          = hint.Type("mitum-nft-holder-value")
+	NFTHolderValueHint          = hint.NewHint(NFTHolderValueType, "v0.0.1")
+)
+
+// NFTCollectionStatsValue is the counts of the nfts in the collection at
+// height.
+type NFTCollectionStatsValue struct {
+	collection     string
+	minted         int64
+	burned         int64
+	holders        int64
+	lastMintHeight base.Height
+	height         base.Height
+}
+
+func NewNFTCollectionStatsValue(
+	collection string,
+	minted, burned, holders int64,
+	lastMintHeight, height base.Height,
+) NFTCollectionStatsValue {
+	return NFTCollectionStatsValue{
+		collection:     collection,
+		minted:         minted,
+		burned:         burned,
+		holders:        holders,
+		lastMintHeight: lastMintHeight,
+		height:         height,
+	}
+}
+
+func (NFTCollectionStatsValue) Hint() hint.Hint {
+	return NFTCollectionStatsValueHint
+}
+
+func (va NFTCollectionStatsValue) Collection() string {
+	return va.collection
+}
+
+func (va NFTCollectionStatsValue) Minted() int64 {
+	return va.minted
+}
+
+func (va NFTCollectionStatsValue) Burned() int64 {
+	return va.burned
+}
+
+func (va NFTCollectionStatsValue) Circulating() int64 {
+	return va.minted - va.burned
+}
+
+func (va NFTCollectionStatsValue) Holders() int64 {
+	return va.holders
+}
+
+func (va NFTCollectionStatsValue) LastMintHeight() base.Height {
+	return va.lastMintHeight
+}
+
+func (va NFTCollectionStatsValue) Height() base.Height {
+	return va.height
+}
+
+// NFTHolderValue is the number of the active nfts of the owner in the
+// collection.
+type NFTHolderValue struct {
+	collection string
+	owner      base.Address
+	count      int64
+	height     base.Height
+}
+
+func NewNFTHolderValue(collection string, owner base.Address, count int64, height base.Height) NFTHolderValue {
+	return NFTHolderValue{
+		collection: collection,
+		owner:      owner,
+		count:      count,
+		height:     height,
+	}
+}
+
+func (NFTHolderValue) Hint() hint.Hint {
+	return NFTHolderValueHint
+}
+
+func (va NFTHolderValue) Collection() string {
+	return va.collection
+}
+
+func (va NFTHolderValue) Owner() base.Address {
+	return va.owner
+}
+
+func (va NFTHolderValue) Count() int64 {
+	return va.count
+}
+
+func (va NFTHolderValue) Height() base.Height {
+	return va.height
+}
+
+// nftCollectionDelta is the changes of the collection by the nfts of a block.
+type nftCollectionDelta struct {
+	minted  int64
+	burned  int64
+	holders map[string]int64
+}
+
+// addNFTChange adds the change from previous to next nft to the deltas by
+// collection. previous is nil for the minted nft.
+func addNFTChange(deltas map[string]*nftCollectionDelta, previous *nft.NFT, next nft.NFT) {
+	symbol := next.ID().Collection().String()
+
+	d, found := deltas[symbol]
+	if !found {
+		d = &nftCollectionDelta{holders: map[string]int64{}}
+		deltas[symbol] = d
+	}
+
+	if previous == nil {
+		d.minted++
+	} else if previous.Active() {
+		d.holders[previous.Owner().String()]--
+	}
+
+	if previous != nil && previous.Active() && !next.Active() {
+		d.burned++
+	}
+
+	if next.Active() {
+		d.holders[next.Owner().String()]++
+	}
+}
+
+// addLatestNFT adds the latest nft to the deltas by collection, as minted and,
+// if not active, burned; the stats are rebuilt from the latest nfts by it.
+func addLatestNFT(deltas map[string]*nftCollectionDelta, n nft.NFT) {
+	addNFTChange(deltas, nil, n)
+
+	if !n.Active() {
+		deltas[n.ID().Collection().String()].burned++
+	}
+}
+
+// changedHolders returns the owners, whose count is changed, in order.
+func (d nftCollectionDelta) changedHolders() []string {
+	var owners []string
+	for i := range d.holders {
+		if d.holders[i] != 0 {
+			owners = append(owners, i)
+		}
+	}
+
+	sort.Strings(owners)
+
+	return owners
+}
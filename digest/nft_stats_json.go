@@ -0,0 +1,48 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type NFTCollectionStatsValueJSONPacker struct {
+	jsonenc.HintedHead
+	CL string      `json:"collection"`
+	MT int64       `json:"minted"`
+	BN int64       `json:"burned"`
+	CC int64       `json:"circulating"`
+	HD int64       `json:"holders"`
+	LM base.Height `json:"last_mint_height"`
+	HT base.Height `json:"height"`
+}
+
+func (va NFTCollectionStatsValue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(NFTCollectionStatsValueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(va.Hint()),
+		CL:         va.collection,
+		MT:         va.minted,
+		BN:         va.burned,
+		CC:         va.Circulating(),
+		HD:         va.holders,
+		LM:         va.lastMintHeight,
+		HT:         va.height,
+	})
+}
+
+type NFTHolderValueJSONPacker struct {
+	jsonenc.HintedHead
+	CL string       `json:"collection"`
+	OW base.Address `json:"owner"`
+	CT int64        `json:"count"`
+	HT base.Height  `json:"height"`
+}
+
+func (va NFTHolderValue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(NFTHolderValueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(va.Hint()),
+		CL:         va.collection,
+		OW:         va.owner,
+		CT:         va.count,
+		HT:         va.height,
+	})
+}
@@ -0,0 +1,112 @@
+package digest
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/spikeekips/mitum/base"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type testNFTStats struct {
+	suite.Suite
+}
+
+func (t *testNFTStats) newNFT(idx uint64, active bool, owner base.Address) nft.NFT {
+	return nft.NewNFT(nft.NewTestNFTID(idx), active, owner, "", "https://localhost:5000/nft", owner, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+}
+
+func (t *testNFTStats) TestMint() {
+	owner := nft.NewTestAddress()
+
+	deltas := map[string]*nftCollectionDelta{}
+	addNFTChange(deltas, nil, t.newNFT(1, true, owner))
+	addNFTChange(deltas, nil, t.newNFT(2, true, owner))
+
+	d := deltas[nft.NewTestNFTID(1).Collection().String()]
+	t.Equal(int64(2), d.minted)
+	t.Equal(int64(0), d.burned)
+	t.Equal(int64(2), d.holders[owner.String()])
+	t.Equal([]string{owner.String()}, d.changedHolders())
+}
+
+func (t *testNFTStats) TestTransferAndBurn() {
+	owner := nft.NewTestAddress()
+	receiver := nft.NewTestAddress()
+
+	minted := t.newNFT(1, true, owner)
+	transferred := t.newNFT(1, true, receiver)
+	burned := t.newNFT(1, false, receiver)
+
+	deltas := map[string]*nftCollectionDelta{}
+	addNFTChange(deltas, &minted, transferred)
+
+	d := deltas[minted.ID().Collection().String()]
+	t.Equal(int64(0), d.minted)
+	t.Equal(int64(-1), d.holders[owner.String()])
+	t.Equal(int64(1), d.holders[receiver.String()])
+
+	deltas = map[string]*nftCollectionDelta{}
+	addNFTChange(deltas, &transferred, burned)
+
+	d = deltas[minted.ID().Collection().String()]
+	t.Equal(int64(1), d.burned)
+	t.Equal(int64(-1), d.holders[receiver.String()])
+}
+
+func (t *testNFTStats) TestLatest() {
+	owner := nft.NewTestAddress()
+	other := nft.NewTestAddress()
+
+	deltas := map[string]*nftCollectionDelta{}
+	addLatestNFT(deltas, t.newNFT(1, true, owner))
+	addLatestNFT(deltas, t.newNFT(2, true, other))
+	addLatestNFT(deltas, t.newNFT(3, false, other))
+
+	d := deltas[nft.NewTestNFTID(1).Collection().String()]
+	t.Equal(int64(3), d.minted)
+	t.Equal(int64(1), d.burned)
+	t.Equal(int64(1), d.holders[owner.String()])
+	t.Equal(int64(1), d.holders[other.String()])
+}
+
+func (t *testNFTStats) TestUnchangedOwner() {
+	owner := nft.NewTestAddress()
+
+	previous := t.newNFT(1, true, owner)
+
+	deltas := map[string]*nftCollectionDelta{}
+	addNFTChange(deltas, &previous, t.newNFT(1, true, owner))
+
+	t.Empty(deltas[previous.ID().Collection().String()].changedHolders())
+}
+
+func (t *testNFTStats) TestHoldersOffset() {
+	filter, err := buildNFTHoldersFilter("ABC", buildNFTHoldersOffset(3, "owner"))
+	t.NoError(err)
+	t.Equal("ABC", filter["collection"])
+	t.NotNil(filter["$or"])
+
+	_, err = buildNFTHoldersFilter("ABC", "owner")
+	t.Error(err)
+}
+
+func (t *testNFTStats) TestHoldersPipeline() {
+	pipeline, err := buildNFTHoldersPipeline("ABC", buildNFTHoldersOffset(3, "owner"), 10)
+	t.NoError(err)
+
+	t.Equal(7, len(pipeline))
+	t.Equal(bson.D{{"$match", bson.M{"collection": "ABC"}}}, pipeline[0])
+	t.Equal("$group", pipeline[2][0].Key)
+
+	match := pipeline[4][0].Value.(bson.M)
+	t.Equal(bson.M{"$gt": 0}, match["count"])
+	t.NotNil(match["$or"])
+
+	t.Equal(bson.D{{"$limit", int64(10)}}, pipeline[6])
+}
+
+func TestNFTStats(t *testing.T) {
+	suite.Run(t, new(testNFTStats))
+}
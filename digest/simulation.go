@@ -0,0 +1,193 @@
+package digest
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	SimulationValueType = hint.Type("mitum-nft-simulation-value")
+	SimulationValueHint = hint.NewHint(SimulationValueType, "v0.0.1")
+)
+
+// SimulationResult is the result of one simulated operation.
+type SimulationResult struct {
+	fact    valuehash.Hash
+	inState bool
+	reason  string
+	fees    map[currency.CurrencyID]currency.Big
+}
+
+func NewSimulationResult(
+	fact valuehash.Hash,
+	inState bool,
+	reason string,
+	fees map[currency.CurrencyID]currency.Big,
+) SimulationResult {
+	return SimulationResult{
+		fact:    fact,
+		inState: inState,
+		reason:  reason,
+		fees:    fees,
+	}
+}
+
+func (sr SimulationResult) Fact() valuehash.Hash {
+	return sr.fact
+}
+
+func (sr SimulationResult) InState() bool {
+	return sr.inState
+}
+
+func (sr SimulationResult) Reason() string {
+	return sr.reason
+}
+
+func (sr SimulationResult) Fees() map[currency.CurrencyID]currency.Big {
+	return sr.fees
+}
+
+// SimulationValue is the states and fees which the operations would make at
+// height.
+type SimulationValue struct {
+	height  base.Height
+	results []SimulationResult
+	states  []state.State
+	fees    map[currency.CurrencyID]currency.Big
+}
+
+func NewSimulationValue(
+	height base.Height,
+	results []SimulationResult,
+	states []state.State,
+	fees map[currency.CurrencyID]currency.Big,
+) SimulationValue {
+	return SimulationValue{
+		height:  height,
+		results: results,
+		states:  states,
+		fees:    fees,
+	}
+}
+
+func (SimulationValue) Hint() hint.Hint {
+	return SimulationValueHint
+}
+
+func (va SimulationValue) Height() base.Height {
+	return va.height
+}
+
+func (va SimulationValue) Results() []SimulationResult {
+	return va.results
+}
+
+func (va SimulationValue) States() []state.State {
+	return va.states
+}
+
+func (va SimulationValue) Fees() map[currency.CurrencyID]currency.Big {
+	return va.fees
+}
+
+// Simulate processes the operations over the states of st without storing
+// them.
+func Simulate(
+	opr *collection.OperationProcessor,
+	st storage.Database,
+	ops []operation.Operation,
+) (SimulationValue, error) {
+	pool, err := storage.NewStatepool(st)
+	if err != nil {
+		return SimulationValue{}, err
+	}
+	defer pool.Done()
+
+	return simulate(opr, pool, ops)
+}
+
+func simulate(
+	opr *collection.OperationProcessor,
+	pool *storage.Statepool,
+	ops []operation.Operation,
+) (SimulationValue, error) {
+	nopr := opr.New(pool).(*collection.OperationProcessor)
+	defer func() {
+		_ = nopr.Cancel()
+	}()
+
+	results := make([]SimulationResult, len(ops))
+	for i := range ops {
+		op := ops[i]
+
+		before := nopr.Fees()
+
+		var reason string
+		switch err := simulateOperation(nopr, op); {
+		case err == nil:
+		case isOperationIgnored(err):
+			reason = err.Error()
+			var operr operation.ReasonError
+			if errors.As(err, &operr) {
+				reason = operr.Msg()
+			}
+		default:
+			return SimulationValue{}, err
+		}
+
+		results[i] = NewSimulationResult(op.Fact().Hash(), len(reason) < 1, reason, feesDelta(before, nopr.Fees()))
+	}
+
+	updates := pool.Updates()
+	sts := make([]state.State, len(updates))
+	for i := range updates {
+		sts[i] = updates[i].GetState()
+	}
+
+	return NewSimulationValue(pool.Height(), results, sts, nopr.Fees()), nil
+}
+
+func simulateOperation(opr *collection.OperationProcessor, op operation.Operation) error {
+	sp, ok := op.(state.Processor)
+	if !ok {
+		return operation.NewBaseReasonError("not operation, %T", op)
+	}
+
+	pop, err := opr.PreProcess(sp)
+	if err != nil {
+		return err
+	}
+
+	return opr.Process(pop)
+}
+
+func isOperationIgnored(err error) bool {
+	var operr operation.ReasonError
+
+	return errors.Is(err, util.IgnoreError) || errors.As(err, &operr)
+}
+
+func feesDelta(before, after map[currency.CurrencyID]currency.Big) map[currency.CurrencyID]currency.Big {
+	fees := map[currency.CurrencyID]currency.Big{}
+	for cid := range after {
+		f := after[cid]
+		if b, found := before[cid]; found {
+			f = f.Sub(b)
+		}
+
+		if f.OverZero() {
+			fees[cid] = f
+		}
+	}
+
+	return fees
+}
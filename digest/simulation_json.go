@@ -0,0 +1,43 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type SimulationResultJSONPacker struct {
+	FC valuehash.Hash                       `json:"fact"`
+	IN bool                                 `json:"in_state"`
+	RS string                               `json:"reason,omitempty"`
+	FE map[currency.CurrencyID]currency.Big `json:"fees"`
+}
+
+func (sr SimulationResult) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SimulationResultJSONPacker{
+		FC: sr.fact,
+		IN: sr.inState,
+		RS: sr.reason,
+		FE: sr.fees,
+	})
+}
+
+type SimulationValueJSONPacker struct {
+	jsonenc.HintedHead
+	HT base.Height                          `json:"height"`
+	RS []SimulationResult                   `json:"operations"`
+	ST []state.State                        `json:"states"`
+	FE map[currency.CurrencyID]currency.Big `json:"fees"`
+}
+
+func (va SimulationValue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SimulationValueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(va.Hint()),
+		HT:         va.height,
+		RS:         va.results,
+		ST:         va.states,
+		FE:         va.fees,
+	})
+}
@@ -0,0 +1,219 @@
+package digest
+
+import (
+	"testing"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	leveldbstorage "github.com/spikeekips/mitum/storage/leveldb"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"github.com/stretchr/testify/suite"
+)
+
+type testSimulation struct {
+	suite.Suite
+	networkID base.NetworkID
+	cid       currency.CurrencyID
+	symbol    extensioncurrency.ContractID
+}
+
+func (t *testSimulation) SetupSuite() {
+	t.networkID = base.NetworkID([]byte("mitum-nft-simulation-test"))
+	t.cid = currency.CurrencyID("SHOWME")
+	t.symbol = extensioncurrency.ContractID("SIMUL")
+}
+
+func (t *testSimulation) newState(key string, v hint.Hinter) state.State {
+	value, err := state.NewHintedValue(v)
+	t.NoError(err)
+
+	st, err := state.NewStateV0(key, value, base.NilHeight)
+	t.NoError(err)
+
+	return st
+}
+
+func (t *testSimulation) newAccount() (key.Privatekey, base.Address, state.State) {
+	priv := key.NewBasePrivatekey()
+	k, err := currency.NewBaseAccountKey(priv.Publickey(), 100)
+	t.NoError(err)
+
+	keys, err := currency.NewBaseAccountKeys([]currency.AccountKey{k}, 100)
+	t.NoError(err)
+
+	ac, err := currency.NewAccountFromKeys(keys)
+	t.NoError(err)
+
+	return priv, ac.Address(), t.newState(currency.StateKeyAccount(ac.Address()), ac)
+}
+
+func (t *testSimulation) newTransfer(
+	priv key.Privatekey,
+	networkID base.NetworkID,
+	sender, receiver base.Address,
+	nid nft.NFTID,
+) collection.Transfer {
+	fact := collection.NewTransferFact(
+		util.UUID().Bytes(),
+		sender,
+		[]collection.TransferItem{collection.NewTransferItem(receiver, nid, t.cid)},
+	)
+
+	sig, err := base.NewFactSignature(priv, fact, networkID)
+	t.NoError(err)
+
+	op, err := collection.NewTransfer(fact, []base.FactSign{base.NewBaseFactSign(priv.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	return op
+}
+
+// newStates returns the states of nft, which is owned by owner.
+func (t *testSimulation) newStates(owner base.Address) (nft.NFTID, []state.State) {
+	ca := extensioncurrency.NewContractAccount(owner, true)
+	parent, err := currency.NewAddressFromKeys(extensioncurrency.NewContractAccountKeys())
+	t.NoError(err)
+
+	policy := collection.NewCollectionPolicy("Collection", 0, "", []base.Address{owner}, "", nil, false, 0, "")
+	design := nft.NewDesign(parent, owner, t.symbol, true, policy)
+	t.NoError(design.IsValid(nil))
+
+	nid := nft.NewNFTID(t.symbol, 1)
+	n := nft.NewNFT(nid, true, owner, "", "https://localhost:5000/nft", owner, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+
+	return nid, []state.State{
+		t.newState(extensioncurrency.StateKeyContractAccount(parent), ca),
+		t.newState(collection.StateKeyCollection(t.symbol), design),
+		t.newState(collection.StateKeyNFT(nid), n),
+	}
+}
+
+func (t *testSimulation) TestUnauthorizedTransfer() {
+	_, owner, ost := t.newAccount()
+	priv, sender, sst := t.newAccount()
+
+	nid, sts := t.newStates(owner)
+	sts = append(sts, ost, sst)
+
+	b := map[string]state.State{}
+	for i := range sts {
+		b[sts[i].Key()] = sts[i]
+	}
+
+	pool, err := storage.NewStatepoolWithBase(leveldbstorage.NewMemDatabase(encoder.NewEncoders(), jsonenc.NewEncoder()), b)
+	t.NoError(err)
+	defer pool.Done()
+
+	copr, err := collection.NewOperationProcessor(nil).
+		SetProcessor(collection.TransferHinter, collection.NewTransferProcessor(nil))
+	t.NoError(err)
+
+	op := t.newTransfer(priv, t.networkID, sender, owner, nid)
+
+	va, err := simulate(copr.(*collection.OperationProcessor), pool, []operation.Operation{op})
+	t.NoError(err)
+
+	t.Equal(1, len(va.Results()))
+
+	r := va.Results()[0]
+	t.True(op.Fact().Hash().Equal(r.Fact()))
+	t.False(r.InState())
+	t.Contains(r.Reason(), "unauthorized sender")
+	t.Empty(va.States())
+	t.Empty(va.Fees())
+}
+
+func (t *testSimulation) TestItemSignature() {
+	priv, sender, _ := t.newAccount()
+	nid := nft.NewNFTID(t.symbol, 1)
+
+	hd := &Handlers{networkID: t.networkID}
+
+	op := t.newTransfer(priv, t.networkID, sender, nft.NewTestAddress(), nid)
+	bad := t.newTransfer(priv, base.NetworkID([]byte("other")), sender, nft.NewTestAddress(), nid)
+
+	ops, err := hd.simulateItem(op)
+	t.NoError(err)
+	t.Equal(1, len(ops))
+
+	_, err = hd.simulateItem(bad)
+	t.True(errors.Is(err, key.SignatureVerificationFailedError))
+
+	// NOTE signature of seal is not checked
+	sl, err := operation.NewBaseSeal(priv, []operation.Operation{op}, base.NetworkID([]byte("other")))
+	t.NoError(err)
+
+	ops, err = hd.simulateItem(sl)
+	t.NoError(err)
+	t.Equal(1, len(ops))
+
+	sl, err = operation.NewBaseSeal(priv, []operation.Operation{bad}, t.networkID)
+	t.NoError(err)
+
+	_, err = hd.simulateItem(sl)
+	t.True(errors.Is(err, key.SignatureVerificationFailedError))
+}
+
+func (t *testSimulation) TestFeesDelta() {
+	before := map[currency.CurrencyID]currency.Big{
+		"MCC": currency.NewBig(10),
+		"ABC": currency.NewBig(3),
+	}
+	after := map[currency.CurrencyID]currency.Big{
+		"MCC": currency.NewBig(15),
+		"ABC": currency.NewBig(3),
+		"XYZ": currency.NewBig(1),
+	}
+
+	fees := feesDelta(before, after)
+	t.Equal(2, len(fees))
+	t.True(currency.NewBig(5).Equal(fees["MCC"]))
+	t.True(currency.NewBig(1).Equal(fees["XYZ"]))
+	t.NotContains(fees, currency.CurrencyID("ABC"))
+}
+
+func (t *testSimulation) TestFeesDeltaEmpty() {
+	t.Empty(feesDelta(nil, nil))
+	t.Empty(feesDelta(nil, map[currency.CurrencyID]currency.Big{}))
+}
+
+func (t *testSimulation) TestMarshalJSON() {
+	fact := valuehash.RandomSHA256()
+	fees := map[currency.CurrencyID]currency.Big{"MCC": currency.NewBig(2)}
+
+	va := NewSimulationValue(base.Height(33), []SimulationResult{
+		NewSimulationResult(fact, true, "", fees),
+		NewSimulationResult(valuehash.RandomSHA256(), false, "insufficient balance", nil),
+	}, nil, fees)
+
+	b, err := jsonenc.Marshal(va)
+	t.NoError(err)
+
+	var m map[string]interface{}
+	t.NoError(jsonenc.Unmarshal(b, &m))
+	t.Equal(float64(33), m["height"])
+
+	ops := m["operations"].([]interface{})
+	t.Equal(2, len(ops))
+	t.Equal(fact.String(), ops[0].(map[string]interface{})["fact"])
+	t.Equal(true, ops[0].(map[string]interface{})["in_state"])
+	t.NotContains(ops[0].(map[string]interface{}), "reason")
+	t.Equal("insufficient balance", ops[1].(map[string]interface{})["reason"])
+	t.Equal("2", m["fees"].(map[string]interface{})["MCC"])
+}
+
+func TestSimulation(t *testing.T) {
+	suite.Run(t, new(testSimulation))
+}
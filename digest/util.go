@@ -55,6 +55,23 @@ func parseHeightFromPath(s string) (base.Height, error) {
 	return base.NewHeightFromString(s)
 }
 
+// parseHeightQuery parses the height query; empty query is base.NilHeight.
+func parseHeightQuery(s string) (base.Height, error) {
+	if len(strings.TrimSpace(s)) < 1 {
+		return base.NilHeight, nil
+	}
+
+	return parseHeightFromPath(s)
+}
+
+func stringHeightQuery(height base.Height) string {
+	if height <= base.NilHeight {
+		return ""
+	}
+
+	return fmt.Sprintf("height=%d", height)
+}
+
 func parseHashFromPath(s string) (valuehash.Hash, error) {
 	s = strings.TrimSpace(s)
 	if len(s) < 1 {
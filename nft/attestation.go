@@ -0,0 +1,34 @@
+package nft
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+)
+
+// AttestationBody returns the bytes signed by nft signer. The signature over
+// nft id, nft hash and resolved uri shows which content of which nft the
+// signer endorses; network id prevents the replay in the other network.
+func AttestationBody(networkID base.NetworkID, id NFTID, h NFTHash, uri URI) []byte {
+	return util.ConcatBytesSlice(networkID, id.Bytes(), h.Bytes(), uri.Bytes())
+}
+
+func VerifyAttestation(
+	pub key.Publickey,
+	sig key.Signature,
+	networkID base.NetworkID,
+	id NFTID,
+	h NFTHash,
+	uri URI,
+) error {
+	if pub == nil || len(sig) < 1 {
+		return errors.Errorf("empty attestation")
+	}
+
+	if err := pub.Verify(AttestationBody(networkID, id, h, uri), sig); err != nil {
+		return errors.Wrap(err, "invalid attestation")
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package nft
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/stretchr/testify/suite"
+)
+
+type testAttestation struct {
+	suite.Suite
+}
+
+func (t *testAttestation) TestVerify() {
+	priv := key.NewBasePrivatekey()
+	h := NewNFTHash(SHA256HashAlgorithm, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+	uri := URI("https://localhost:5000/nft")
+	networkID := base.NetworkID([]byte("mitum-nft-test"))
+	id := NewTestNFTID(1)
+
+	sig, err := priv.Sign(AttestationBody(networkID, id, h, uri))
+	t.NoError(err)
+
+	signer := MustNewSigner(NewTestAddress(), 10, true, priv.Publickey(), sig)
+	t.True(signer.Attested())
+	t.NoError(signer.VerifyAttestation(networkID, id, h, uri))
+
+	err = signer.VerifyAttestation(networkID, id, h, "https://localhost:5000/other")
+	t.Contains(err.Error(), "invalid attestation")
+
+	err = VerifyAttestation(key.NewBasePrivatekey().Publickey(), sig, networkID, id, h, uri)
+	t.Contains(err.Error(), "invalid attestation")
+}
+
+func (t *testAttestation) TestReplay() {
+	priv := key.NewBasePrivatekey()
+	h := NewNFTHash(SHA256HashAlgorithm, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+	uri := URI("https://localhost:5000/nft")
+	networkID := base.NetworkID([]byte("mitum-nft-test"))
+	id := NewTestNFTID(1)
+
+	sig, err := priv.Sign(AttestationBody(networkID, id, h, uri))
+	t.NoError(err)
+
+	signer := MustNewSigner(NewTestAddress(), 10, true, priv.Publickey(), sig)
+
+	err = signer.VerifyAttestation(networkID, NewTestNFTID(2), h, uri)
+	t.Contains(err.Error(), "invalid attestation")
+
+	err = signer.VerifyAttestation(base.NetworkID([]byte("other-network")), id, h, uri)
+	t.Contains(err.Error(), "invalid attestation")
+}
+
+func (t *testAttestation) TestEmpty() {
+	signer := MustNewSigner(NewTestAddress(), 10, true, nil, nil)
+	t.False(signer.Attested())
+
+	err := signer.VerifyAttestation(nil, NewTestNFTID(1), "", "")
+	t.Contains(err.Error(), "empty attestation")
+}
+
+func (t *testAttestation) TestSignerIsValid() {
+	priv := key.NewBasePrivatekey()
+	sig, err := priv.Sign(AttestationBody(nil, NewTestNFTID(1), "", ""))
+	t.NoError(err)
+
+	err = NewSigner(NewTestAddress(), 10, true, priv.Publickey(), nil).IsValid(nil)
+	t.Contains(err.Error(), "must be given together")
+
+	err = NewSigner(NewTestAddress(), 10, false, priv.Publickey(), sig).IsValid(nil)
+	t.Contains(err.Error(), "attestation of unsigned signer")
+}
+
+func TestAttestation(t *testing.T) {
+	suite.Run(t, new(testAttestation))
+}
@@ -79,7 +79,7 @@ func (t *testApproveItem) TestZeroIDX() {
 	t.Contains(err.Error(), "nid idx must be over zero")
 }
 
-func (t *testApproveItem) TestOverMaxIDX() {
+func (t *testApproveItem) TestOverDefaultMaxIDX() {
 	sender := MustAddress(util.UUID().String())
 	approved := MustAddress(util.UUID().String())
 
@@ -104,8 +104,7 @@ func (t *testApproveItem) TestOverMaxIDX() {
 	approve, err := NewApprove(fact, fs, "")
 	t.NoError(err)
 
-	err = approve.IsValid(nil)
-	t.Contains(err.Error(), "nid idx over max")
+	t.NoError(approve.IsValid(nil))
 }
 
 func TestApproveItem(t *testing.T) {
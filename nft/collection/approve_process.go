@@ -80,10 +80,22 @@ func (ipp *ApproveItemProcessor) PreProcess(
 		ipp.nft = nv
 		ipp.nst = st
 	}
-	if !ipp.nft.Owner().Equal(ipp.sender) {
-		if err := checkExistsState(currency.StateKeyAccount(ipp.nft.Owner()), getState); err != nil {
+
+	// NOTE nft owned by parent nft is controlled by the account owning the
+	// parent, so it can not be approved.
+	if _, ok := ipp.nft.Owner().(nft.NFTAddress); ok {
+		return errors.Errorf("nft owned by parent nft cannot be approved; %q", nid)
+	}
+
+	owner, err := nftAccountOwner(ipp.nft, getState)
+	if err != nil {
+		return err
+	}
+
+	if !owner.Equal(ipp.sender) {
+		if err := checkExistsState(currency.StateKeyAccount(owner), getState); err != nil {
 			return err
-		} else if st, err := existsState(StateKeyAgents(ipp.nft.Owner(), ipp.nft.ID().Collection()), "agents", getState); err != nil {
+		} else if st, err := existsState(StateKeyAgents(owner, ipp.nft.ID().Collection()), "agents", getState); err != nil {
 			return errors.Errorf("unauthorized sender; %q", ipp.sender)
 		} else if box, err := StateAgentsValue(st); err != nil {
 			return err
@@ -308,6 +308,39 @@ func (t *testApproveOperations) TestUnauthorizedSender() {
 	t.Contains(err.Error(), "unauthorized sender")
 }
 
+func (t *testApproveOperations) TestNestedNFT() {
+	var sts = []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+	approved, ast := t.newAccount(true, nil)
+
+	sts = append(sts, pst)
+	sts = append(sts, sst...)
+	sts = append(sts, ast...)
+
+	pid := nft.NewNFTID(t.symbol, 1)
+	nid := nft.NewNFTID(t.symbol, 2)
+	na := nft.NewNFTAddress(pid)
+	pn := nft.NewNFT(pid, true, sender.Address, "", "https://localhost:5000/nft/1", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	n := nft.NewNFT(nid, true, na, "", "https://localhost:5000/nft/2", na, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	sts = append(sts, t.newStateNFT(pn), t.newStateNFT(n))
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{pid, nid}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	approve := t.newApprove(sender.Address, sender.Privs(), []ApproveItem{t.newApproveItem(approved.Address, nid, t.cid)})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err := opr.Process(approve)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "cannot be approved")
+}
+
 func (t *testApproveOperations) TestMultipleItemsWithFee() {
 	sts := []state.State{}
 
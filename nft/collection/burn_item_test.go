@@ -77,7 +77,7 @@ func (t *testBurnItem) TestZeroIDX() {
 	t.Contains(err.Error(), "nid idx must be over zero")
 }
 
-func (t *testBurnItem) TestOverMaxIDX() {
+func (t *testBurnItem) TestOverDefaultMaxIDX() {
 	sender := MustAddress(util.UUID().String())
 
 	token := util.UUID().Bytes()
@@ -101,8 +101,7 @@ func (t *testBurnItem) TestOverMaxIDX() {
 	burn, err := NewBurn(fact, fs, "")
 	t.NoError(err)
 
-	err = burn.IsValid(nil)
-	t.Contains(err.Error(), "nid idx over max")
+	t.NoError(burn.IsValid(nil))
 }
 
 func TestBurnItem(t *testing.T) {
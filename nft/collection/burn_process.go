@@ -33,13 +33,16 @@ func (Burn) Process(
 }
 
 type BurnItemProcessor struct {
-	cp     *extensioncurrency.CurrencyPool
-	h      valuehash.Hash
-	box    *NFTBox
-	nft    nft.NFT
-	nst    state.State
-	sender base.Address
-	item   BurnItem
+	cp       *extensioncurrency.CurrencyPool
+	h        valuehash.Hash
+	box      *NFTBox
+	holdings *NFTBox
+	parent   *NFTBox
+	hashes   []*NFTBox
+	nft      nft.NFT
+	nst      state.State
+	sender   base.Address
+	item     BurnItem
 }
 
 func (ipp *BurnItemProcessor) PreProcess(
@@ -69,6 +72,12 @@ func (ipp *BurnItemProcessor) PreProcess(
 		approved = nv.Approved()
 		owner = nv.Owner()
 
+		// NOTE approval of nft owned by parent nft is ignored; the account
+		// owning the parent controls it.
+		if _, ok := owner.(nft.NFTAddress); ok {
+			approved = nil
+		}
+
 		n := nft.NewNFT(nv.ID(), false, nv.Owner(), nv.NftHash(), nv.Uri(), nv.Owner(), nv.Creators(), nv.Copyrighters())
 		if err := n.IsValid(nil); err != nil {
 			return err
@@ -78,8 +87,23 @@ func (ipp *BurnItemProcessor) PreProcess(
 		ipp.nst = st
 	}
 
-	// check authorization
-	if !(owner.Equal(ipp.sender) || approved.Equal(ipp.sender)) {
+	// check authorization; nft owned by parent nft follows the account owning
+	// the parent
+	if a, _, err := walkNFTOwner(owner, getState); err != nil {
+		return err
+	} else {
+		owner = a
+	}
+
+	// NOTE burned nft can not leave the nfts it owns out of control of
+	// accounts.
+	if box, _, err := loadNFTChildren(nid, getState); err != nil {
+		return err
+	} else if !box.IsEmpty() {
+		return errors.Errorf("nft owns other nfts; %q", nid)
+	}
+
+	if !(owner.Equal(ipp.sender) || (approved != nil && approved.Equal(ipp.sender))) {
 		// check agent
 		if st, err := existsState(StateKeyAgents(owner, ipp.nft.ID().Collection()), "agents", getState); err != nil {
 			return errors.Errorf("unauthorized sender; %q", ipp.sender)
@@ -104,6 +128,28 @@ func (ipp *BurnItemProcessor) Process(
 		return nil, err
 	}
 
+	if err := ipp.holdings.Remove(ipp.nft.ID()); err != nil {
+		return nil, err
+	}
+
+	if ipp.parent != nil {
+		if err := ipp.parent.Remove(ipp.nft.ID()); err != nil {
+			return nil, err
+		}
+	}
+
+	// NOTE the nfts of legacy nft box are not indexed by nft hash until their
+	// collection mints first.
+	for i := range ipp.hashes {
+		if !ipp.hashes[i].Exists(ipp.nft.ID()) {
+			continue
+		}
+
+		if err := ipp.hashes[i].Remove(ipp.nft.ID()); err != nil {
+			return nil, err
+		}
+	}
+
 	if st, err := SetStateNFTValue(ipp.nst, ipp.nft); err != nil {
 		return nil, err
 	} else {
@@ -119,6 +165,9 @@ func (ipp *BurnItemProcessor) Close() error {
 	ipp.nft = nft.NFT{}
 	ipp.nst = nil
 	ipp.box = nil
+	ipp.holdings = nil
+	ipp.parent = nil
+	ipp.hashes = nil
 	ipp.sender = nil
 	ipp.item = BurnItem{}
 	BurnItemProcessorPool.Put(ipp)
@@ -129,8 +178,13 @@ func (ipp *BurnItemProcessor) Close() error {
 type BurnProcessor struct {
 	cp *extensioncurrency.CurrencyPool
 	Burn
-	boxes        map[extensioncurrency.ContractID]*NFTBox
-	boxStates    map[extensioncurrency.ContractID]state.State
+	boxes        map[string]*NFTBox
+	boxStates    map[string]state.State
+	holdings     *holdingsPool
+	children     map[string]*NFTBox
+	cstates      map[string]state.State
+	hashes       map[string]*NFTBox
+	hashStates   map[string]state.State
 	ipps         []*BurnItemProcessor
 	amountStates map[currency.CurrencyID]currency.AmountState
 	required     map[currency.CurrencyID][2]currency.Big
@@ -149,6 +203,11 @@ func NewBurnProcessor(cp *extensioncurrency.CurrencyPool) currency.GetNewProcess
 		opp.Burn = i
 		opp.boxes = nil
 		opp.boxStates = nil
+		opp.holdings = nil
+		opp.children = nil
+		opp.cstates = nil
+		opp.hashes = nil
+		opp.hashStates = nil
 		opp.ipps = nil
 		opp.amountStates = nil
 		opp.required = nil
@@ -174,21 +233,22 @@ func (opp *BurnProcessor) PreProcess(
 		return nil, operation.NewBaseReasonError(err.Error())
 	}
 
-	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getState); err != nil {
-		return nil, operation.NewBaseReasonError("contract account cannot burn nfts; %q", fact.Sender())
-	}
-
 	if err := checkFactSignsByState(fact.Sender(), opp.Signs(), getState); err != nil {
 		return nil, operation.NewBaseReasonError("invalid signing; %w", err)
 	}
 
-	opp.boxes = map[extensioncurrency.ContractID]*NFTBox{}
-	opp.boxStates = map[extensioncurrency.ContractID]state.State{}
+	opp.boxes = map[string]*NFTBox{}
+	opp.boxStates = map[string]state.State{}
+	opp.holdings = newHoldingsPool()
+	opp.children = map[string]*NFTBox{}
+	opp.cstates = map[string]state.State{}
+	opp.hashes = map[string]*NFTBox{}
+	opp.hashStates = map[string]state.State{}
+	designs := map[extensioncurrency.ContractID]struct{}{}
 	for i := range fact.items {
 		collection := fact.items[i].NFT().Collection()
 
-		if _, found := opp.boxes[collection]; !found {
-			var box NFTBox
+		if _, found := designs[collection]; !found {
 			if st, err := existsState(StateKeyCollection(collection), "design", getState); err != nil {
 				return nil, operation.NewBaseReasonError(err.Error())
 			} else if design, err := StateCollectionValue(st); err != nil {
@@ -202,27 +262,29 @@ func (opp *BurnProcessor) PreProcess(
 			} else if !ca.IsActive() {
 				return nil, operation.NewBaseReasonError("deactivated contract account; %q", design.Parent())
 			}
+			designs[collection] = struct{}{}
+		}
 
-			if st, err := existsState(StateKeyNFTs(collection), "nfts", getState); err != nil {
-				return nil, operation.NewBaseReasonError(err.Error())
-			} else if b, err := StateNFTsValue(st); err != nil {
+		nid := fact.items[i].NFT()
+		key := StateKeyNFTsPage(collection, NFTBoxPage(nid.Idx()))
+		if _, found := opp.boxes[key]; !found {
+			box, st, err := loadNFTBoxPage(nid, getState)
+			if err != nil {
 				return nil, operation.NewBaseReasonError(err.Error())
-			} else {
-				box = b
-				opp.boxStates[collection] = st
 			}
-			opp.boxes[collection] = &box
+			opp.boxes[key] = &box
+			opp.boxStates[key] = st
 		}
-
 	}
 
 	ipps := make([]*BurnItemProcessor, len(fact.items))
 	for i := range fact.items {
+		nid := fact.items[i].NFT()
 
 		c := BurnItemProcessorPool.Get().(*BurnItemProcessor)
 		c.cp = opp.cp
 		c.h = opp.Hash()
-		c.box = opp.boxes[fact.items[i].NFT().Collection()]
+		c.box = opp.boxes[StateKeyNFTsPage(nid.Collection(), NFTBoxPage(nid.Idx()))]
 		c.nft = nft.NFT{}
 		c.nst = nil
 		c.sender = fact.Sender()
@@ -232,6 +294,48 @@ func (opp *BurnProcessor) PreProcess(
 			return nil, operation.NewBaseReasonError(err.Error())
 		}
 
+		owner := c.nft.Owner()
+		holdings, err := opp.holdings.load(owner, nid.Collection(), getState)
+		if err != nil {
+			return nil, operation.NewBaseReasonError(err.Error())
+		}
+		c.holdings = holdings
+
+		if na, ok := owner.(nft.NFTAddress); ok {
+			pid, err := na.NFTID()
+			if err != nil {
+				return nil, operation.NewBaseReasonError(err.Error())
+			}
+
+			ckey := StateKeyNFTChildren(pid)
+			if _, found := opp.children[ckey]; !found {
+				box, st, err := loadNFTChildren(pid, getState)
+				if err != nil {
+					return nil, operation.NewBaseReasonError(err.Error())
+				}
+				opp.children[ckey] = &box
+				opp.cstates[ckey] = st
+			}
+			c.parent = opp.children[ckey]
+		}
+
+		if h := c.nft.NftHash(); len(h) > 0 {
+			keys := []string{StateKeyCollectionNFTHash(nid.Collection(), h), StateKeyNFTHash(h)}
+			for _, k := range keys {
+				if _, found := opp.hashes[k]; found {
+					continue
+				}
+
+				box, st, err := loadNFTHashBox(k, getState)
+				if err != nil {
+					return nil, operation.NewBaseReasonError(err.Error())
+				}
+				opp.hashes[k] = &box
+				opp.hashStates[k] = st
+			}
+			c.hashes = []*NFTBox{opp.hashes[keys[0]], opp.hashes[keys[1]]}
+		}
+
 		ipps[i] = c
 	}
 
@@ -272,8 +376,30 @@ func (opp *BurnProcessor) Process(
 		}
 	}
 
-	for c, box := range opp.boxes {
-		if st, err := SetStateNFTsValue(opp.boxStates[c], *box); err != nil {
+	for k, box := range opp.boxes {
+		if st, err := SetStateNFTsValue(opp.boxStates[k], *box); err != nil {
+			return operation.NewBaseReasonError(err.Error())
+		} else {
+			states = append(states, st)
+		}
+	}
+
+	if sts, err := opp.holdings.States(); err != nil {
+		return operation.NewBaseReasonError(err.Error())
+	} else {
+		states = append(states, sts...)
+	}
+
+	for k, box := range opp.children {
+		if st, err := SetStateNFTsValue(opp.cstates[k], *box); err != nil {
+			return operation.NewBaseReasonError(err.Error())
+		} else {
+			states = append(states, st)
+		}
+	}
+
+	for k, box := range opp.hashes {
+		if st, err := SetStateNFTsValue(opp.hashStates[k], *box); err != nil {
 			return operation.NewBaseReasonError(err.Error())
 		} else {
 			states = append(states, st)
@@ -297,6 +423,11 @@ func (opp *BurnProcessor) Close() error {
 	opp.Burn = Burn{}
 	opp.boxes = nil
 	opp.boxStates = nil
+	opp.holdings = nil
+	opp.children = nil
+	opp.cstates = nil
+	opp.hashes = nil
+	opp.hashStates = nil
 	opp.ipps = nil
 	opp.amountStates = nil
 	opp.required = nil
@@ -188,6 +188,7 @@ func (t *testBurnOperations) TestAgentBurn() {
 
 	_, dst := t.newCollectionDesign(true, parent, owner.Address, []base.Address{owner.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
 	sts = append(sts, dst...)
+	sts = append(sts, t.newStateHoldings(owner.Address, t.symbol, []nft.NFTID{nid}))
 
 	items := []BurnItem{t.newBurnItem(nid, t.cid)}
 	approve := t.newBurn(agent.Address, agent.Privs(), items)
@@ -243,6 +244,7 @@ func (t *testBurnOperations) TestApprovedBurn() {
 
 	_, dst := t.newCollectionDesign(true, parent, owner.Address, []base.Address{owner.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
 	sts = append(sts, dst...)
+	sts = append(sts, t.newStateHoldings(owner.Address, t.symbol, []nft.NFTID{nid}))
 
 	items := []BurnItem{t.newBurnItem(nid, t.cid)}
 	approve := t.newBurn(approved.Address, approved.Privs(), items)
@@ -338,6 +340,7 @@ func (t *testBurnOperations) TestMultipleItemsWithFee() {
 
 	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid0, nid1}, []nft.NFTID{})
 	sts = append(sts, dst...)
+	sts = append(sts, t.newStateHoldings(sender.Address, t.symbol, []nft.NFTID{nid0, nid1}))
 
 	pool, _ := t.statepool(sts)
 
@@ -389,6 +392,197 @@ func (t *testBurnOperations) TestMultipleItemsWithFee() {
 	t.False(nf1.Active())
 }
 
+func (t *testBurnOperations) TestHoldings() {
+	sts := []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(33), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+
+	sts = append(sts, sst...)
+	sts = append(sts, pst)
+
+	nid := nft.NewNFTID(t.symbol, 1)
+	n := nft.NewNFT(nid, true, sender.Address, "", "https://localhost:5000/nft/1", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	sts = append(sts, t.newStateNFT(n), t.newStateHoldings(sender.Address, t.symbol, []nft.NFTID{nid}))
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	burn := t.newBurn(sender.Address, sender.Privs(), []BurnItem{t.newBurnItem(nid, t.cid)})
+	t.NoError(opr.Process(burn))
+
+	var box NFTBox
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyHoldings(sender.Address, t.symbol) {
+			box, _ = StateNFTsValue(st.GetState())
+		}
+	}
+
+	t.True(box.IsEmpty())
+}
+
+func (t *testBurnOperations) TestReleaseNFTHash() {
+	sts := []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(33), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+
+	sts = append(sts, sst...)
+	sts = append(sts, pst)
+
+	h := nft.NewNFTHash(nft.SHA256HashAlgorithm, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+
+	nid0 := nft.NewNFTID(t.symbol, 1)
+	nid1 := nft.NewNFTID(t.symbol, 2)
+	n := nft.NewNFT(nid0, true, sender.Address, h, "https://localhost:5000/nft/1", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	sts = append(sts, t.newStateNFT(n), t.newStateHoldings(sender.Address, t.symbol, []nft.NFTID{nid0}))
+
+	for _, k := range []string{StateKeyCollectionNFTHash(t.symbol, h), StateKeyNFTHash(h)} {
+		value, _ := state.NewHintedValue(NewNFTBox([]nft.NFTID{nid0, nid1}))
+		st, err := state.NewStateV0(k, value, base.NilHeight)
+		t.NoError(err)
+		sts = append(sts, st)
+	}
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid0}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	burn := t.newBurn(sender.Address, sender.Privs(), []BurnItem{t.newBurnItem(nid0, t.cid)})
+	t.NoError(opr.Process(burn))
+
+	var cbox, gbox NFTBox
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyCollectionNFTHash(t.symbol, h):
+			cbox, _ = StateNFTsValue(st.GetState())
+		case StateKeyNFTHash(h):
+			gbox, _ = StateNFTsValue(st.GetState())
+		}
+	}
+
+	t.Equal([]nft.NFTID{nid1}, cbox.NFTs())
+	t.Equal([]nft.NFTID{nid1}, gbox.NFTs())
+}
+
+// newNestedNFTs returns the states of sender nfts; the first nft owns the
+// second.
+func (t *testBurnOperations) newNestedNFTs() (*account, []nft.NFTID, []state.State) {
+	sts := []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(33), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+
+	sts = append(sts, sst...)
+	sts = append(sts, pst)
+
+	nids := []nft.NFTID{nft.NewNFTID(t.symbol, 1), nft.NewNFTID(t.symbol, 2)}
+	na := nft.NewNFTAddress(nids[0])
+
+	n0 := nft.NewNFT(nids[0], true, sender.Address, "", "https://localhost:5000/nft/1", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	n1 := nft.NewNFT(nids[1], true, na, "", "https://localhost:5000/nft/2", na, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+
+	sts = append(sts, t.newStateNFT(n0), t.newStateNFT(n1))
+	sts = append(sts, t.newStateHoldings(sender.Address, t.symbol, []nft.NFTID{nids[0]}))
+	sts = append(sts, t.newStateHoldings(na, t.symbol, []nft.NFTID{nids[1]}))
+	sts = append(sts, t.newStateNFTChildren(nids[0], []nft.NFTID{nids[1]}))
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, nids, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	return sender, nids, sts
+}
+
+func (t *testBurnOperations) TestBurnParentWithChildren() {
+	sender, nids, sts := t.newNestedNFTs()
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	burn := t.newBurn(sender.Address, sender.Privs(), []BurnItem{t.newBurnItem(nids[0], t.cid)})
+	err := opr.Process(burn)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "nft owns other nfts")
+}
+
+func (t *testBurnOperations) TestBurnChild() {
+	sender, nids, sts := t.newNestedNFTs()
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	burn := t.newBurn(sender.Address, sender.Privs(), []BurnItem{t.newBurnItem(nids[1], t.cid)})
+	t.NoError(opr.Process(burn))
+
+	var updated []state.State
+	var children NFTBox
+	for _, st := range pool.Updates() {
+		updated = append(updated, st.GetState())
+		if st.Key() == StateKeyNFTChildren(nids[0]) {
+			children, _ = StateNFTsValue(st.GetState())
+		}
+	}
+
+	t.True(children.IsEmpty())
+
+	pool, _ = t.statepool(sts, updated)
+	opr = t.processor(nil, pool)
+
+	burn = t.newBurn(sender.Address, sender.Privs(), []BurnItem{t.newBurnItem(nids[0], t.cid)})
+	t.NoError(opr.Process(burn))
+}
+
+func (t *testBurnOperations) TestLegacyNFTBox() {
+	sts := []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(33), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+
+	sts = append(sts, sst...)
+	sts = append(sts, pst)
+
+	nid0 := nft.NewNFTID(t.symbol, 1)
+	nid1 := nft.NewNFTID(t.symbol, NFTBoxPageSize+1)
+	n0 := nft.NewNFT(nid0, true, sender.Address, "", "https://localhost:5000/nft/1", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	sts = append(sts, t.newStateNFT(n0))
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	legacyValue, _ := state.NewHintedValue(NewNFTBox([]nft.NFTID{nid0, nid1}))
+	legacyState, err := state.NewStateV0(StateKeyNFTs(t.symbol), legacyValue, base.NilHeight)
+	t.NoError(err)
+	sts = append(sts, legacyState)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	burn := t.newBurn(sender.Address, sender.Privs(), []BurnItem{t.newBurnItem(nid0, t.cid)})
+	t.NoError(opr.Process(burn))
+
+	var page NFTBox
+	var legacyUpdated bool
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyNFTsPage(t.symbol, 0):
+			page, _ = StateNFTsValue(st.GetState())
+		case StateKeyNFTs(t.symbol):
+			legacyUpdated = true
+		}
+	}
+
+	t.False(legacyUpdated)
+	t.False(page.Exists(nid0))
+	t.False(page.Exists(nid1))
+}
+
 func (t *testBurnOperations) TestInsufficientMultipleItemsWithFee() {
 	sts := []state.State{}
 
@@ -508,6 +702,7 @@ func (t *testBurnOperations) TestSameSenders() {
 
 	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid0, nid1}, []nft.NFTID{})
 	sts = append(sts, dst...)
+	sts = append(sts, t.newStateHoldings(sender.Address, t.symbol, []nft.NFTID{nid0, nid1}))
 
 	pool, _ := t.statepool(sts)
 
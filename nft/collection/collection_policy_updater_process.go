@@ -71,10 +71,6 @@ func (opp *CollectionPolicyUpdaterProcessor) PreProcess(
 		return nil, operation.NewBaseReasonError(err.Error())
 	}
 
-	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getState); err != nil {
-		return nil, operation.NewBaseReasonError("contract account cannot update collection policy; %q", fact.Sender())
-	}
-
 	if st, err := existsState(StateKeyCollection(fact.Collection()), "design", getState); err != nil {
 		return nil, operation.NewBaseReasonError(err.Error())
 	} else if design, err := StateCollectionValue(st); err != nil {
@@ -98,6 +94,14 @@ func (opp *CollectionPolicyUpdaterProcessor) PreProcess(
 		return nil, operation.NewBaseReasonError(err.Error())
 	}
 
+	if st, err := existsState(StateKeyCollectionLastIDX(fact.Collection()), "collection idx", getState); err != nil {
+		return nil, operation.NewBaseReasonError(err.Error())
+	} else if idx, err := StateCollectionLastIDXValue(st); err != nil {
+		return nil, operation.NewBaseReasonError(err.Error())
+	} else if limit := fact.Policy().Limit(); idx > limit {
+		return nil, operation.NewBaseReasonError("collection limit under last nft idx; %d < %d", limit, idx)
+	}
+
 	if err := checkFactSignsByState(fact.Sender(), opp.Signs(), getState); err != nil {
 		return nil, operation.NewBaseReasonError("invalid signing; %w", err)
 	}
@@ -71,7 +71,7 @@ func (t *testCollectionPolicyUpdaterOperations) TestSenderNotExist() {
 	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{}, []nft.NFTID{})
 	sts = append(sts, dst...)
 
-	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{})
+	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	cpu := t.newCollectionPolicyUpdater(sender.Address, sender.Privs(), t.symbol, policy, t.cid)
 
 	pool, _ := t.statepool(sts)
@@ -94,7 +94,7 @@ func (t *testCollectionPolicyUpdaterOperations) TestCollectionNotExist() {
 	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
 	sts = append(sts, sst...)
 
-	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{})
+	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	cpu := t.newCollectionPolicyUpdater(sender.Address, sender.Privs(), t.symbol, policy, t.cid)
 
 	pool, _ := t.statepool(sts)
@@ -123,7 +123,7 @@ func (t *testCollectionPolicyUpdaterOperations) TestCollectionDeactivated() {
 	_, dst := t.newCollectionDesign(false, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{}, []nft.NFTID{})
 	sts = append(sts, dst...)
 
-	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{})
+	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	cpu := t.newCollectionPolicyUpdater(sender.Address, sender.Privs(), t.symbol, policy, t.cid)
 
 	pool, _ := t.statepool(sts)
@@ -154,7 +154,7 @@ func (t *testCollectionPolicyUpdaterOperations) TestSenderUnathorized() {
 	_, dst := t.newCollectionDesign(true, parent, creator.Address, []base.Address{creator.Address}, t.symbol, []nft.NFTID{}, []nft.NFTID{})
 	sts = append(sts, dst...)
 
-	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{})
+	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	cpu := t.newCollectionPolicyUpdater(sender.Address, sender.Privs(), t.symbol, policy, t.cid)
 
 	pool, _ := t.statepool(sts)
@@ -195,7 +195,7 @@ func (t *testCollectionPolicyUpdaterOperations) TestOperationWithFee() {
 	opr := t.processor(cp, pool)
 
 	token := util.UUID().Bytes()
-	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{})
+	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	fact := NewCollectionPolicyUpdaterFact(token, sender.Address, t.symbol, policy, t.cid)
 	sig, err := base.NewFactSignature(sender.Privs()[0], fact, nil)
 	t.NoError(err)
@@ -219,6 +219,81 @@ func (t *testCollectionPolicyUpdaterOperations) TestOperationWithFee() {
 	t.Equal(fee, amst.(currency.AmountState).Fee())
 }
 
+func (t *testCollectionPolicyUpdaterOperations) TestContractAccountSender() {
+	sts := []state.State{}
+
+	owner, ost := t.newAccount(true, nil)
+	sts = append(sts, ost...)
+
+	treasury := nft.NewTestAddress()
+	tv, _ := state.NewHintedValue(extensioncurrency.NewContractAccount(owner.Address, true))
+	tst, err := state.NewStateV0(extensioncurrency.StateKeyContractAccount(treasury), tv, base.NilHeight)
+	t.NoError(err)
+	sts = append(sts, tst, t.newStateKeys(treasury, extensioncurrency.NewContractAccountKeys()))
+	sts = append(sts, t.newStateAmount(treasury, currency.NewAmount(currency.NewBig(33), t.cid)))
+
+	parent, _, pst := t.newContractAccount(true, true, treasury)
+	sts = append(sts, pst)
+
+	_, dst := t.newCollectionDesign(true, parent, treasury, []base.Address{treasury}, t.symbol, []nft.NFTID{}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	pool, _ := t.statepool(sts)
+	feeer := extensioncurrency.NewFixedFeeer(owner.Address, currency.ZeroBig, currency.ZeroBig)
+
+	cp := extensioncurrency.NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, currency.NewBig(99), nft.NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
+	cpu := t.newCollectionPolicyUpdater(treasury, owner.Privs(), t.symbol, policy, t.cid)
+
+	t.NoError(opr.Process(cpu))
+}
+
+func (t *testCollectionPolicyUpdaterOperations) TestLimitUnderLastIdx() {
+	sts := []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(33), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+
+	sts = append(sts, sst...)
+	sts = append(sts, pst)
+
+	actives := []nft.NFTID{
+		nft.NewNFTID(t.symbol, 1),
+		nft.NewNFTID(t.symbol, 2),
+		nft.NewNFTID(t.symbol, 3),
+	}
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, actives, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	pool, _ := t.statepool(sts)
+
+	feeer := extensioncurrency.NewFixedFeeer(sender.Address, currency.ZeroBig, currency.ZeroBig)
+
+	cp := extensioncurrency.NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, currency.NewBig(99), nft.NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	token := util.UUID().Bytes()
+	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 2, "")
+	fact := NewCollectionPolicyUpdaterFact(token, sender.Address, t.symbol, policy, t.cid)
+	sig, err := base.NewFactSignature(sender.Privs()[0], fact, nil)
+	t.NoError(err)
+	fs := []base.FactSign{base.NewBaseFactSign(sender.Privs()[0].Publickey(), sig)}
+	cpu, err := NewCollectionPolicyUpdater(fact, fs, "")
+	t.NoError(err)
+
+	err = opr.Process(cpu)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "collection limit under last nft idx")
+}
+
 func (t *testCollectionPolicyUpdaterOperations) TestInSufficientBalanceWithFee() {
 	var sts = []state.State{}
 
@@ -232,7 +307,7 @@ func (t *testCollectionPolicyUpdaterOperations) TestInSufficientBalanceWithFee()
 	sts = append(sts, dst...)
 
 	fee := currency.NewBig(34)
-	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{})
+	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	cpu := t.newCollectionPolicyUpdater(sender.Address, sender.Privs(), t.symbol, policy, t.cid)
 
 	pool, _ := t.statepool(sts)
@@ -276,7 +351,7 @@ func (t *testCollectionPolicyUpdaterOperations) TestSameSenders() {
 	opr := t.processor(cp, pool)
 
 	token0 := util.UUID().Bytes()
-	policy0 := NewCollectionPolicy("Collection0", 0, "", []base.Address{})
+	policy0 := NewCollectionPolicy("Collection0", 0, "", []base.Address{}, "", nil, false, 0, "")
 	fact0 := NewCollectionPolicyUpdaterFact(token0, sender.Address, t.symbol, policy0, t.cid)
 	sig0, err := base.NewFactSignature(sender.Privs()[0], fact0, nil)
 	t.NoError(err)
@@ -287,7 +362,7 @@ func (t *testCollectionPolicyUpdaterOperations) TestSameSenders() {
 	t.NoError(opr.Process(cpu0))
 
 	token1 := util.UUID().Bytes()
-	policy1 := NewCollectionPolicy("Collection1", 1, "", []base.Address{})
+	policy1 := NewCollectionPolicy("Collection1", 1, "", []base.Address{}, "", nil, false, 0, "")
 	fact1 := NewCollectionPolicyUpdaterFact(token1, sender.Address, extensioncurrency.ContractID("ABC"), policy1, t.cid)
 	sig1, err := base.NewFactSignature(sender.Privs()[0], fact1, nil)
 	t.NoError(err)
@@ -336,7 +411,7 @@ func (t *testCollectionPolicyUpdaterOperations) TestUnderThreshold() {
 
 	opr := t.processor(cp, pool)
 
-	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{})
+	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	cpu := t.newCollectionPolicyUpdater(sender, pks, t.symbol, policy, t.cid)
 
 	err := opr.Process(cpu)
@@ -364,7 +439,7 @@ func (t *testCollectionPolicyUpdaterOperations) TestUnknownKey() {
 
 	opr := t.processor(cp, pool)
 
-	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{})
+	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	cpu := t.newCollectionPolicyUpdater(sender.Address, []key.Privatekey{sender.Priv, key.NewBasePrivatekey()}, t.symbol, policy, t.cid)
 
 	err := opr.Process(cpu)
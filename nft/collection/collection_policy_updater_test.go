@@ -25,7 +25,7 @@ func (t *testCollectionPolicyUpdater) TestNew() {
 
 	token := util.UUID().Bytes()
 
-	policy := NewCollectionPolicy("New Collection", 0, "https://localhost:5000/collection", []base.Address{sender})
+	policy := NewCollectionPolicy("New Collection", 0, "https://localhost:5000/collection", []base.Address{sender}, "", nil, false, 0, "")
 	fact := NewCollectionPolicyUpdaterFact(token, sender, extensioncurrency.ContractID("ABC"), policy, "MCC")
 
 	var fs []base.FactSign
@@ -54,7 +54,7 @@ func (t *testCollectionPolicyUpdater) TestOverMaxRoyalty() {
 	sender := MustAddress(util.UUID().String())
 	token := util.UUID().Bytes()
 
-	policy := NewCollectionPolicy("New Collection", nft.PaymentParameter(nft.MaxPaymentParameter+1), "https://localhost:5000/collection", []base.Address{sender})
+	policy := NewCollectionPolicy("New Collection", nft.PaymentParameter(nft.MaxPaymentParameter+1), "https://localhost:5000/collection", []base.Address{sender}, "", nil, false, 0, "")
 	fact := NewCollectionPolicyUpdaterFact(token, sender, extensioncurrency.ContractID("ABC"), policy, "MCC")
 
 	var fs []base.FactSign
@@ -82,7 +82,7 @@ func (t *testCollectionPolicyUpdater) TestEmptyUri() {
 
 	token := util.UUID().Bytes()
 
-	policy := NewCollectionPolicy("New Collection", 0, "", []base.Address{sender})
+	policy := NewCollectionPolicy("New Collection", 0, "", []base.Address{sender}, "", nil, false, 0, "")
 	fact := NewCollectionPolicyUpdaterFact(token, sender, extensioncurrency.ContractID("ABC"), policy, "MCC")
 
 	var fs []base.FactSign
@@ -112,7 +112,7 @@ func (t *testCollectionPolicyUpdater) TestSpaceUri() {
 
 	token := util.UUID().Bytes()
 
-	policy := NewCollectionPolicy("New Collection", 0, "     ", []base.Address{sender})
+	policy := NewCollectionPolicy("New Collection", 0, "     ", []base.Address{sender}, "", nil, false, 0, "")
 	fact := NewCollectionPolicyUpdaterFact(token, sender, extensioncurrency.ContractID("ABC"), policy, "MCC")
 
 	var fs []base.FactSign
@@ -140,7 +140,7 @@ func (t *testCollectionPolicyUpdater) TestNotTrimmedUri() {
 	token := util.UUID().Bytes()
 
 	uri := "   https://localhost:5000/collection   "
-	policy := NewCollectionPolicy("New Collection", 0, nft.URI(uri), []base.Address{sender})
+	policy := NewCollectionPolicy("New Collection", 0, nft.URI(uri), []base.Address{sender}, "", nil, false, 0, "")
 	fact := NewCollectionPolicyUpdaterFact(token, sender, extensioncurrency.ContractID("ABC"), policy, "MCC")
 
 	var fs []base.FactSign
@@ -170,7 +170,7 @@ func (t *testCollectionPolicyUpdater) TestOverMaxUri() {
 	token := util.UUID().Bytes()
 
 	uri := strings.Repeat("a", nft.MaxURILength+1)
-	policy := NewCollectionPolicy("New Collection", 0, nft.URI(uri), []base.Address{sender})
+	policy := NewCollectionPolicy("New Collection", 0, nft.URI(uri), []base.Address{sender}, "", nil, false, 0, "")
 	fact := NewCollectionPolicyUpdaterFact(token, sender, extensioncurrency.ContractID("ABC"), policy, "MCC")
 
 	var fs []base.FactSign
@@ -209,7 +209,7 @@ func (t *testCollectionPolicyUpdater) TestOverMaxWhites() {
 		MustAddress(util.UUID().String()),
 		MustAddress(util.UUID().String()),
 		MustAddress(util.UUID().String()),
-	})
+	}, "", nil, false, 0, "")
 	fact := NewCollectionPolicyUpdaterFact(token, sender, extensioncurrency.ContractID("ABC"), policy, "MCC")
 
 	var fs []base.FactSign
@@ -236,7 +236,7 @@ func (t *testCollectionPolicyUpdater) TestEmptyWhites() {
 	sender := MustAddress(util.UUID().String())
 	token := util.UUID().Bytes()
 
-	policy := NewCollectionPolicy("New Collection", 0, "https://localhost:5000/collection", []base.Address{})
+	policy := NewCollectionPolicy("New Collection", 0, "https://localhost:5000/collection", []base.Address{}, "", nil, false, 0, "")
 	fact := NewCollectionPolicyUpdaterFact(token, sender, extensioncurrency.ContractID("ABC"), policy, "MCC")
 
 	var fs []base.FactSign
@@ -267,7 +267,7 @@ func (t *testCollectionPolicyUpdater) TestDuplicateWhites() {
 
 	token := util.UUID().Bytes()
 
-	policy := NewCollectionPolicy("New Collection", 0, "https://localhost:5000/collection", []base.Address{white, white})
+	policy := NewCollectionPolicy("New Collection", 0, "https://localhost:5000/collection", []base.Address{white, white}, "", nil, false, 0, "")
 	fact := NewCollectionPolicyUpdaterFact(token, sender, extensioncurrency.ContractID("ABC"), policy, "MCC")
 
 	var fs []base.FactSign
@@ -294,7 +294,7 @@ func (t *testCollectionPolicyUpdater) TestOverSizeMemo() {
 	sender := MustAddress(util.UUID().String())
 	token := util.UUID().Bytes()
 
-	policy := NewCollectionPolicy("New Collection", 0, "https://localhost:5000/collection", []base.Address{sender})
+	policy := NewCollectionPolicy("New Collection", 0, "https://localhost:5000/collection", []base.Address{sender}, "", nil, false, 0, "")
 	fact := NewCollectionPolicyUpdaterFact(token, sender, extensioncurrency.ContractID("ABC"), policy, "MCC")
 
 	var fs []base.FactSign
@@ -19,12 +19,17 @@ var (
 
 type CollectionRegisterForm struct {
 	hint.BaseHinter
-	target  base.Address
-	symbol  extensioncurrency.ContractID
-	name    CollectionName
-	royalty nft.PaymentParameter
-	uri     nft.URI
-	whites  []base.Address
+	target        base.Address
+	symbol        extensioncurrency.ContractID
+	name          CollectionName
+	royalty       nft.PaymentParameter
+	uri           nft.URI
+	whites        []base.Address
+	hashAlgorithm nft.HashAlgorithm
+	schemes       []nft.URIScheme
+	minterIdx     bool
+	limit         uint64
+	uniqueness    HashUniqueness
 }
 
 func NewCollectionRegisterForm(
@@ -34,15 +39,25 @@ func NewCollectionRegisterForm(
 	royalty nft.PaymentParameter,
 	uri nft.URI,
 	whites []base.Address,
+	hashAlgorithm nft.HashAlgorithm,
+	schemes []nft.URIScheme,
+	minterIdx bool,
+	limit uint64,
+	uniqueness HashUniqueness,
 ) CollectionRegisterForm {
 	return CollectionRegisterForm{
-		BaseHinter: hint.NewBaseHinter(CollectionRegisterFormHint),
-		target:     target,
-		symbol:     symbol,
-		name:       name,
-		royalty:    royalty,
-		uri:        uri,
-		whites:     whites,
+		BaseHinter:    hint.NewBaseHinter(CollectionRegisterFormHint),
+		target:        target,
+		symbol:        symbol,
+		name:          name,
+		royalty:       royalty,
+		uri:           uri,
+		whites:        whites,
+		hashAlgorithm: hashAlgorithm,
+		schemes:       schemes,
+		minterIdx:     minterIdx,
+		limit:         limit,
+		uniqueness:    uniqueness,
 	}
 }
 
@@ -52,8 +67,13 @@ func MustNewCollectionRegisterForm(target base.Address,
 	royalty nft.PaymentParameter,
 	uri nft.URI,
 	whites []base.Address,
+	hashAlgorithm nft.HashAlgorithm,
+	schemes []nft.URIScheme,
+	minterIdx bool,
+	limit uint64,
+	uniqueness HashUniqueness,
 ) CollectionRegisterForm {
-	form := NewCollectionRegisterForm(target, symbol, name, royalty, uri, whites)
+	form := NewCollectionRegisterForm(target, symbol, name, royalty, uri, whites, hashAlgorithm, schemes, minterIdx, limit, uniqueness)
 
 	if err := form.IsValid(nil); err != nil {
 		panic(err)
@@ -68,14 +88,34 @@ func (form CollectionRegisterForm) Bytes() []byte {
 		as[i] = form.whites[i].Bytes()
 	}
 
-	return util.ConcatBytesSlice(
+	ss := make([][]byte, len(form.schemes))
+	for i := range form.schemes {
+		ss[i] = form.schemes[i].Bytes()
+	}
+
+	bs := [][]byte{
 		form.target.Bytes(),
 		form.symbol.Bytes(),
 		form.name.Bytes(),
 		form.royalty.Bytes(),
 		form.uri.Bytes(),
 		util.ConcatBytesSlice(as...),
-	)
+		form.hashAlgorithm.Bytes(),
+		util.ConcatBytesSlice(ss...),
+		form.uniqueness.Bytes(),
+	}
+
+	// NOTE minterIdx and limit are left out unless set, so the bytes of the
+	// form made before them keep the same.
+	if form.minterIdx {
+		bs = append(bs, util.BoolToBytes(form.minterIdx))
+	}
+
+	if form.limit > 0 {
+		bs = append(bs, util.Uint64ToBytes(form.limit))
+	}
+
+	return util.ConcatBytesSlice(bs...)
 }
 
 func (form CollectionRegisterForm) Target() base.Address {
@@ -102,6 +142,26 @@ func (form CollectionRegisterForm) Whites() []base.Address {
 	return form.whites
 }
 
+func (form CollectionRegisterForm) HashAlgorithm() nft.HashAlgorithm {
+	return form.hashAlgorithm
+}
+
+func (form CollectionRegisterForm) Schemes() []nft.URIScheme {
+	return form.schemes
+}
+
+func (form CollectionRegisterForm) MinterIdx() bool {
+	return form.minterIdx
+}
+
+func (form CollectionRegisterForm) Limit() uint64 {
+	return form.limit
+}
+
+func (form CollectionRegisterForm) HashUniqueness() HashUniqueness {
+	return form.uniqueness
+}
+
 func (form CollectionRegisterForm) Addresses() ([]base.Address, error) {
 	l := 1 + len(form.whites)
 
@@ -127,6 +187,10 @@ func (form CollectionRegisterForm) IsValid([]byte) error {
 		return err
 	}
 
+	if err := form.uri.CheckTemplate(); err != nil {
+		return err
+	}
+
 	if l := len(form.whites); l > MaxWhiteAddress {
 		return isvalid.InvalidError.Errorf("address in white list over allowed; %d > %d", l, MaxWhiteAddress)
 	}
@@ -143,7 +207,21 @@ func (form CollectionRegisterForm) IsValid([]byte) error {
 		founds[acc] = struct{}{}
 	}
 
-	return nil
+	if form.hashAlgorithm != "" {
+		if err := form.hashAlgorithm.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if err := form.uniqueness.IsValid(nil); err != nil {
+		return err
+	}
+
+	if form.limit > nft.MaxNFTIdxLimit {
+		return isvalid.InvalidError.Errorf("limit over allowed; %d > %d", form.limit, nft.MaxNFTIdxLimit)
+	}
+
+	return isValidURISchemes(form.schemes)
 }
 
 func (form CollectionRegisterForm) Rebuild() CollectionRegisterForm {
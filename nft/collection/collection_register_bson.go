@@ -13,12 +13,17 @@ func (form CollectionRegisterForm) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(form.Hint()),
 			bson.M{
-				"target":  form.target,
-				"symbol":  form.symbol,
-				"name":    form.name,
-				"royalty": form.royalty,
-				"uri":     form.uri,
-				"whites":  form.whites,
+				"target":          form.target,
+				"symbol":          form.symbol,
+				"name":            form.name,
+				"royalty":         form.royalty,
+				"uri":             form.uri,
+				"whites":          form.whites,
+				"hash_algorithm":  form.hashAlgorithm,
+				"schemes":         form.schemes,
+				"minter_idx":      form.minterIdx,
+				"limit":           form.limit,
+				"hash_uniqueness": form.uniqueness,
 			}))
 }
 
@@ -29,6 +34,11 @@ type CollectionRegisterFormBSONUnpacker struct {
 	RY uint                  `bson:"royalty"`
 	UR string                `bson:"uri"`
 	WH []base.AddressDecoder `bson:"whites"`
+	HA string                `bson:"hash_algorithm"`
+	SC []string              `bson:"schemes"`
+	MI bool                  `bson:"minter_idx"`
+	LM uint64                `bson:"limit"`
+	HU string                `bson:"hash_uniqueness"`
 }
 
 func (form *CollectionRegisterForm) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -37,7 +47,7 @@ func (form *CollectionRegisterForm) UnpackBSON(b []byte, enc *bsonenc.Encoder) e
 		return err
 	}
 
-	return form.unpack(enc, uf.TG, uf.SB, uf.NM, uf.RY, uf.UR, uf.WH)
+	return form.unpack(enc, uf.TG, uf.SB, uf.NM, uf.RY, uf.UR, uf.WH, uf.HA, uf.SC, uf.MI, uf.LM, uf.HU)
 }
 
 func (fact CollectionRegisterFact) MarshalBSON() ([]byte, error) {
@@ -18,6 +18,11 @@ func (form *CollectionRegisterForm) unpack(
 	royalty uint,
 	uri string,
 	bws []base.AddressDecoder,
+	hashAlgorithm string,
+	schemes []string,
+	minterIdx bool,
+	limit uint64,
+	uniqueness string,
 ) error {
 	target, err := bt.Encode(enc)
 	if err != nil {
@@ -39,6 +44,16 @@ func (form *CollectionRegisterForm) unpack(
 		}
 	}
 	form.whites = whites
+	form.hashAlgorithm = nft.HashAlgorithm(hashAlgorithm)
+
+	ss := make([]nft.URIScheme, len(schemes))
+	for i := range schemes {
+		ss[i] = nft.URIScheme(schemes[i])
+	}
+	form.schemes = ss
+	form.minterIdx = minterIdx
+	form.limit = limit
+	form.uniqueness = HashUniqueness(uniqueness)
 
 	return nil
 }
@@ -19,6 +19,11 @@ type CollectionRegisterFormJSONPacker struct {
 	RY nft.PaymentParameter         `json:"royalty"`
 	UR nft.URI                      `json:"uri"`
 	WH []base.Address               `json:"whites"`
+	HA nft.HashAlgorithm            `json:"hash_algorithm"`
+	SC []nft.URIScheme              `json:"schemes"`
+	MI bool                         `json:"minter_idx"`
+	LM uint64                       `json:"limit"`
+	HU HashUniqueness               `json:"hash_uniqueness"`
 }
 
 func (form CollectionRegisterForm) MarshalJSON() ([]byte, error) {
@@ -30,6 +35,11 @@ func (form CollectionRegisterForm) MarshalJSON() ([]byte, error) {
 		RY:         form.royalty,
 		UR:         form.uri,
 		WH:         form.whites,
+		HA:         form.hashAlgorithm,
+		SC:         form.schemes,
+		MI:         form.minterIdx,
+		LM:         form.limit,
+		HU:         form.uniqueness,
 	})
 }
 
@@ -40,6 +50,11 @@ type CollectionRegisterFormJSONUnpacker struct {
 	RY uint                  `json:"royalty"`
 	UR string                `json:"uri"`
 	WH []base.AddressDecoder `json:"whites"`
+	HA string                `json:"hash_algorithm"`
+	SC []string              `json:"schemes"`
+	MI bool                  `json:"minter_idx"`
+	LM uint64                `json:"limit"`
+	HU string                `json:"hash_uniqueness"`
 }
 
 func (form *CollectionRegisterForm) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -47,7 +62,7 @@ func (form *CollectionRegisterForm) UnpackJSON(b []byte, enc *jsonenc.Encoder) e
 	if err := enc.Unmarshal(b, &uf); err != nil {
 		return err
 	}
-	return form.unpack(enc, uf.TG, uf.SB, uf.NM, uf.RY, uf.UR, uf.WH)
+	return form.unpack(enc, uf.TG, uf.SB, uf.NM, uf.RY, uf.UR, uf.WH, uf.HA, uf.SC, uf.MI, uf.LM, uf.HU)
 }
 
 type CollectionRegisterFactJSONPacker struct {
@@ -73,10 +73,6 @@ func (opp *CollectionRegisterProcessor) PreProcess(
 		return nil, operation.NewBaseReasonError(err.Error())
 	}
 
-	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getState); err != nil {
-		return nil, operation.NewBaseReasonError("contract account cannot register a collection; %q", fact.Sender())
-	}
-
 	if st, err := existsState(extensioncurrency.StateKeyContractAccount(fact.Form().Target()), "contract account", getState); err != nil {
 		return nil, operation.NewBaseReasonError(err.Error())
 	} else if ca, err := extensioncurrency.StateContractAccountValue(st); err != nil {
@@ -103,12 +99,10 @@ func (opp *CollectionRegisterProcessor) PreProcess(
 	for i := range whites {
 		if err := checkExistsState(currency.StateKeyAccount(whites[i]), getState); err != nil {
 			return nil, operation.NewBaseReasonError(err.Error())
-		} else if err = checkNotExistsState(extensioncurrency.StateKeyContractAccount(whites[i]), getState); err != nil {
-			return nil, operation.NewBaseReasonError("contract account cannot be whitelisted; %q", whites[i])
 		}
 	}
 
-	policy := NewCollectionPolicy(fact.Form().Name(), fact.Form().Royalty(), fact.Form().Uri(), whites)
+	policy := NewCollectionPolicy(fact.Form().Name(), fact.Form().Royalty(), fact.Form().Uri(), whites, fact.Form().HashAlgorithm(), fact.Form().Schemes(), fact.Form().MinterIdx(), fact.Form().Limit(), fact.Form().HashUniqueness())
 	if err := policy.IsValid(nil); err != nil {
 		return nil, operation.NewBaseReasonError(err.Error())
 	}
@@ -68,7 +68,7 @@ func (t *testCollectionRegisterOperations) TestSenderNotExist() {
 	parent, _, pst := t.newContractAccount(true, true, sender.Address)
 	sts = append(sts, pst)
 
-	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{sender.Address})
+	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{sender.Address}, "", nil, false, 0, "")
 	cr := t.newCollectionRegister(sender.Address, sender.Privs(), form, t.cid)
 
 	pool, _ := t.statepool(sts)
@@ -92,7 +92,7 @@ func (t *testCollectionRegisterOperations) TestParentNotExist() {
 	parent, _, _ := t.newContractAccount(false, true, sender.Address)
 	sts = append(sts, sst...)
 
-	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{sender.Address})
+	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{sender.Address}, "", nil, false, 0, "")
 	cr := t.newCollectionRegister(sender.Address, sender.Privs(), form, t.cid)
 
 	pool, _ := t.statepool(sts)
@@ -117,7 +117,7 @@ func (t *testCollectionRegisterOperations) TestParentDeactivated() {
 	sts = append(sts, sst...)
 	sts = append(sts, pst)
 
-	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{sender.Address})
+	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{sender.Address}, "", nil, false, 0, "")
 	cr := t.newCollectionRegister(sender.Address, sender.Privs(), form, t.cid)
 
 	pool, _ := t.statepool(sts)
@@ -155,7 +155,7 @@ func (t *testCollectionRegisterOperations) TestOperationWithFee() {
 	opr := t.processor(cp, pool)
 
 	token := util.UUID().Bytes()
-	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{})
+	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	fact := NewCollectionRegisterFact(token, sender.Address, form, t.cid)
 	sig, err := base.NewFactSignature(sender.Privs()[0], fact, nil)
 	t.NoError(err)
@@ -179,6 +179,45 @@ func (t *testCollectionRegisterOperations) TestOperationWithFee() {
 	t.Equal(fee, amst.(currency.AmountState).Fee())
 }
 
+func (t *testCollectionRegisterOperations) TestContractAccountSender() {
+	sts := []state.State{}
+
+	owner, ost := t.newAccount(true, nil)
+	sts = append(sts, ost...)
+
+	treasury := nft.NewTestAddress()
+	tv, _ := state.NewHintedValue(extensioncurrency.NewContractAccount(owner.Address, true))
+	tst, err := state.NewStateV0(extensioncurrency.StateKeyContractAccount(treasury), tv, base.NilHeight)
+	t.NoError(err)
+	sts = append(sts, tst, t.newStateKeys(treasury, extensioncurrency.NewContractAccountKeys()))
+	sts = append(sts, t.newStateAmount(treasury, currency.NewAmount(currency.NewBig(33), t.cid)))
+
+	parent, _, pst := t.newContractAccount(true, true, treasury)
+	sts = append(sts, pst)
+
+	pool, _ := t.statepool(sts)
+	feeer := extensioncurrency.NewFixedFeeer(owner.Address, currency.ZeroBig, currency.ZeroBig)
+
+	cp := extensioncurrency.NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, currency.NewBig(99), nft.NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
+	cr := t.newCollectionRegister(treasury, owner.Privs(), form, t.cid)
+
+	t.NoError(opr.Process(cr))
+
+	var design nft.Design
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyCollection(t.symbol) {
+			design, _ = StateCollectionValue(st.GetState())
+		}
+	}
+
+	t.True(design.Creator().Equal(treasury))
+}
+
 func (t *testCollectionRegisterOperations) TestInSufficientBalanceWithFee() {
 	var sts = []state.State{}
 
@@ -189,7 +228,7 @@ func (t *testCollectionRegisterOperations) TestInSufficientBalanceWithFee() {
 	sts = append(sts, pst)
 
 	fee := currency.NewBig(34)
-	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{})
+	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	cr := t.newCollectionRegister(sender.Address, sender.Privs(), form, t.cid)
 
 	pool, _ := t.statepool(sts)
@@ -227,7 +266,7 @@ func (t *testCollectionRegisterOperations) TestSameSenders() {
 	opr := t.processor(cp, pool)
 
 	token0 := util.UUID().Bytes()
-	form0 := NewCollectionRegisterForm(parent, t.symbol, "Collection0", 0, "", []base.Address{})
+	form0 := NewCollectionRegisterForm(parent, t.symbol, "Collection0", 0, "", []base.Address{}, "", nil, false, 0, "")
 	fact0 := NewCollectionRegisterFact(token0, sender.Address, form0, t.cid)
 	sig0, err := base.NewFactSignature(sender.Privs()[0], fact0, nil)
 	t.NoError(err)
@@ -238,7 +277,7 @@ func (t *testCollectionRegisterOperations) TestSameSenders() {
 	t.NoError(opr.Process(cpu0))
 
 	token1 := util.UUID().Bytes()
-	form1 := NewCollectionRegisterForm(parent, extensioncurrency.ContractID("ABC"), "Collection1", 1, "", []base.Address{})
+	form1 := NewCollectionRegisterForm(parent, extensioncurrency.ContractID("ABC"), "Collection1", 1, "", []base.Address{}, "", nil, false, 0, "")
 	fact1 := NewCollectionRegisterFact(token1, sender.Address, form1, t.cid)
 	sig1, err := base.NewFactSignature(sender.Privs()[0], fact1, nil)
 	t.NoError(err)
@@ -282,7 +321,7 @@ func (t *testCollectionRegisterOperations) TestUnderThreshold() {
 
 	opr := t.processor(cp, pool)
 
-	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{})
+	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	cr := t.newCollectionRegister(sender, pks, form, t.cid)
 
 	err := opr.Process(cr)
@@ -308,7 +347,7 @@ func (t *testCollectionRegisterOperations) TestUnknownKey() {
 
 	opr := t.processor(cp, pool)
 
-	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{})
+	form := NewCollectionRegisterForm(parent, t.symbol, "Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
 	cr := t.newCollectionRegister(sender.Address, []key.Privatekey{sender.Priv, key.NewBasePrivatekey()}, form, t.cid)
 
 	err := opr.Process(cr)
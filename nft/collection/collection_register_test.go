@@ -27,7 +27,7 @@ func (t *testCollectionRegister) TestNew() {
 	token := util.UUID().Bytes()
 
 	form := NewCollectionRegisterForm(
-		target, extensioncurrency.ContractID("ABC"), "Collection", 0, "https://localhost:5000/collection", []base.Address{sender},
+		target, extensioncurrency.ContractID("ABC"), "Collection", 0, "https://localhost:5000/collection", []base.Address{sender}, "", nil, false, 0, "",
 	)
 	fact := NewCollectionRegisterFact(token, sender, form, "MCC")
 
@@ -58,7 +58,7 @@ func (t *testCollectionRegister) TestSameSenderTarget() {
 	token := util.UUID().Bytes()
 
 	form := NewCollectionRegisterForm(
-		sender, extensioncurrency.ContractID("ABC"), "Collection", 0, "https://localhost:5000/collection", []base.Address{sender},
+		sender, extensioncurrency.ContractID("ABC"), "Collection", 0, "https://localhost:5000/collection", []base.Address{sender}, "", nil, false, 0, "",
 	)
 	fact := NewCollectionRegisterFact(token, sender, form, "MCC")
 
@@ -88,7 +88,7 @@ func (t *testCollectionRegister) TestOverMaxRoyalty() {
 	token := util.UUID().Bytes()
 
 	form := NewCollectionRegisterForm(
-		target, extensioncurrency.ContractID("ABC"), "Collection", nft.PaymentParameter(nft.MaxPaymentParameter+1), "https://localhost:5000/collection", []base.Address{sender},
+		target, extensioncurrency.ContractID("ABC"), "Collection", nft.PaymentParameter(nft.MaxPaymentParameter+1), "https://localhost:5000/collection", []base.Address{sender}, "", nil, false, 0, "",
 	)
 	fact := NewCollectionRegisterFact(token, sender, form, "MCC")
 
@@ -119,7 +119,7 @@ func (t *testCollectionRegister) TestEmptyUri() {
 	token := util.UUID().Bytes()
 
 	form := NewCollectionRegisterForm(
-		target, extensioncurrency.ContractID("ABC"), "Collection", 0, "", []base.Address{sender},
+		target, extensioncurrency.ContractID("ABC"), "Collection", 0, "", []base.Address{sender}, "", nil, false, 0, "",
 	)
 	fact := NewCollectionRegisterFact(token, sender, form, "MCC")
 
@@ -152,7 +152,7 @@ func (t *testCollectionRegister) TestSpaceUri() {
 	token := util.UUID().Bytes()
 
 	form := NewCollectionRegisterForm(
-		target, extensioncurrency.ContractID("ABC"), "Collection", 0, "      ", []base.Address{sender},
+		target, extensioncurrency.ContractID("ABC"), "Collection", 0, "      ", []base.Address{sender}, "", nil, false, 0, "",
 	)
 	fact := NewCollectionRegisterFact(token, sender, form, "MCC")
 
@@ -184,7 +184,7 @@ func (t *testCollectionRegister) TestNotTrimmedUri() {
 
 	uri := "   https://localhost:5000/collection   "
 	form := NewCollectionRegisterForm(
-		target, extensioncurrency.ContractID("ABC"), "Collection", 0, nft.URI(uri), []base.Address{sender},
+		target, extensioncurrency.ContractID("ABC"), "Collection", 0, nft.URI(uri), []base.Address{sender}, "", nil, false, 0, "",
 	)
 	fact := NewCollectionRegisterFact(token, sender, form, "MCC")
 
@@ -218,7 +218,7 @@ func (t *testCollectionRegister) TestOverMaxUri() {
 
 	uri := strings.Repeat("a", nft.MaxURILength+1)
 	form := NewCollectionRegisterForm(
-		target, extensioncurrency.ContractID("ABC"), "Collection", 0, nft.URI(uri), []base.Address{sender},
+		target, extensioncurrency.ContractID("ABC"), "Collection", 0, nft.URI(uri), []base.Address{sender}, "", nil, false, 0, "",
 	)
 	fact := NewCollectionRegisterFact(token, sender, form, "MCC")
 
@@ -262,6 +262,11 @@ func (t *testCollectionRegister) TestOverMaxWhites() {
 			MustAddress(util.UUID().String()),
 			MustAddress(util.UUID().String()),
 		},
+		"",
+		nil,
+		false,
+		0,
+		"",
 	)
 	fact := NewCollectionRegisterFact(token, sender, form, "MCC")
 
@@ -292,7 +297,7 @@ func (t *testCollectionRegister) TestEmptyWhites() {
 	token := util.UUID().Bytes()
 
 	form := NewCollectionRegisterForm(
-		target, extensioncurrency.ContractID("ABC"), "Collection", 0, "https://localhost:5000/collection", []base.Address{},
+		target, extensioncurrency.ContractID("ABC"), "Collection", 0, "https://localhost:5000/collection", []base.Address{}, "", nil, false, 0, "",
 	)
 	fact := NewCollectionRegisterFact(token, sender, form, "MCC")
 
@@ -330,7 +335,7 @@ func (t *testCollectionRegister) TestDuplicateWhites() {
 		"Collection",
 		0,
 		"https://localhost:5000/collection",
-		[]base.Address{white, white},
+		[]base.Address{white, white}, "", nil, false, 0, "",
 	)
 	fact := NewCollectionRegisterFact(token, sender, form, "MCC")
 
@@ -361,7 +366,7 @@ func (t *testCollectionRegister) TestOverSizeMemo() {
 	token := util.UUID().Bytes()
 
 	form := NewCollectionRegisterForm(
-		target, extensioncurrency.ContractID("ABC"), "Collection", 0, "https://localhost:5000/collection", []base.Address{sender},
+		target, extensioncurrency.ContractID("ABC"), "Collection", 0, "https://localhost:5000/collection", []base.Address{sender}, "", nil, false, 0, "",
 	)
 	fact := NewCollectionRegisterFact(token, sender, form, "MCC")
 
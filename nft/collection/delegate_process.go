@@ -140,10 +140,6 @@ func (opp *DelegateProcessor) PreProcess(
 		return nil, operation.NewBaseReasonError(err.Error())
 	}
 
-	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getState); err != nil {
-		return nil, operation.NewBaseReasonError("contract account cannot have agents; %q", fact.Sender())
-	}
-
 	opp.box = map[extensioncurrency.ContractID]*AgentBox{}
 	opp.boxState = map[extensioncurrency.ContractID]state.State{}
 	for i := range fact.items {
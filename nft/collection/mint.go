@@ -1,6 +1,7 @@
 package collection
 
 import (
+	"github.com/ProtoconNet/mitum-nft/nft"
 	"github.com/pkg/errors"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
@@ -123,6 +124,35 @@ func (fact MintFact) Addresses() ([]base.Address, error) {
 	return as, nil
 }
 
+// signingAccounts returns sender and the creators and copyrighters signed at
+// minting. Their fact signs must be attached to the operation.
+func (fact MintFact) signingAccounts() []base.Address {
+	as := []base.Address{fact.sender}
+	founds := map[string]struct{}{fact.sender.String(): {}}
+
+	for i := range fact.items {
+		form := fact.items[i].Form()
+
+		signers := append([]nft.Signer{}, form.Creators().Signers()...)
+		signers = append(signers, form.Copyrighters().Signers()...)
+
+		for j := range signers {
+			if !signers[j].Signed() {
+				continue
+			}
+
+			a := signers[j].Account()
+			if _, found := founds[a.String()]; found {
+				continue
+			}
+			founds[a.String()] = struct{}{}
+			as = append(as, a)
+		}
+	}
+
+	return as
+}
+
 func (fact MintFact) Items() []MintItem {
 	return fact.items
 }
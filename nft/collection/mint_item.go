@@ -89,13 +89,11 @@ func (form MintForm) IsValid([]byte) error {
 		return err
 	}
 
-	if len(form.uri.String()) < 1 {
-		return isvalid.InvalidError.Errorf("empty uri")
-	}
-
 	return nil
 }
 
+var MaxAirdropReceivers = 100
+
 var (
 	MintItemType   = hint.Type("mitum-nft-mint-item")
 	MintItemHint   = hint.NewHint(MintItemType, "v0.0.1")
@@ -106,22 +104,48 @@ type MintItem struct {
 	hint.BaseHinter
 	collection extensioncurrency.ContractID
 	form       MintForm
+	receivers  []base.Address
+	idxes      []uint64
 	cid        currency.CurrencyID
 }
 
-func NewMintItem(symbol extensioncurrency.ContractID, form MintForm, cid currency.CurrencyID) MintItem {
+// NewMintItem creates MintItem. The nft is minted to sender if receivers is
+// empty. With multiple receivers, one nft is minted per receiver. idxes are
+// given only for the collection whose policy lets minters choose the idx, one
+// for each nft minted.
+func NewMintItem(
+	symbol extensioncurrency.ContractID,
+	form MintForm,
+	receivers []base.Address,
+	idxes []uint64,
+	cid currency.CurrencyID,
+) MintItem {
 	return MintItem{
 		BaseHinter: hint.NewBaseHinter(MintItemHint),
 		collection: symbol,
 		form:       form,
+		receivers:  receivers,
+		idxes:      idxes,
 		cid:        cid,
 	}
 }
 
 func (it MintItem) Bytes() []byte {
+	rs := make([][]byte, len(it.receivers))
+	for i := range it.receivers {
+		rs[i] = it.receivers[i].Bytes()
+	}
+
+	is := make([][]byte, len(it.idxes))
+	for i := range it.idxes {
+		is[i] = util.Uint64ToBytes(it.idxes[i])
+	}
+
 	return util.ConcatBytesSlice(
 		it.collection.Bytes(),
 		it.form.Bytes(),
+		util.ConcatBytesSlice(rs...),
+		util.ConcatBytesSlice(is...),
 		it.cid.Bytes(),
 	)
 }
@@ -131,21 +155,99 @@ func (it MintItem) IsValid([]byte) error {
 		return err
 	}
 
+	if l := len(it.receivers); l > MaxAirdropReceivers {
+		return isvalid.InvalidError.Errorf("receivers over allowed; %d > %d", l, MaxAirdropReceivers)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range it.receivers {
+		if err := it.receivers[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[it.receivers[i].String()]; found {
+			return isvalid.InvalidError.Errorf("duplicate receiver found; %q", it.receivers[i])
+		}
+		founds[it.receivers[i].String()] = struct{}{}
+	}
+
+	// NOTE attestation is signed for one nft id, so it can not be shared by
+	// the nfts of airdrop.
+	if len(it.receivers) > 1 && (hasSignedSigner(it.form.Creators()) || hasSignedSigner(it.form.Copyrighters())) {
+		return isvalid.InvalidError.Errorf(
+			"signed creators or copyrighters not allowed with multiple receivers; %d receivers", len(it.receivers))
+	}
+
+	if len(it.idxes) < 1 {
+		return nil
+	}
+
+	if l := len(it.idxes); l != it.Count() {
+		return isvalid.InvalidError.Errorf("idxes not matched with nfts to mint; %d != %d", l, it.Count())
+	}
+
+	idxes := map[uint64]struct{}{}
+	for i := range it.idxes {
+		if it.idxes[i] == 0 {
+			return isvalid.InvalidError.Errorf("idx must be over zero")
+		}
+
+		if _, found := idxes[it.idxes[i]]; found {
+			return isvalid.InvalidError.Errorf("duplicate idx found; %d", it.idxes[i])
+		}
+		idxes[it.idxes[i]] = struct{}{}
+	}
+
 	return nil
 }
 
+func hasSignedSigner(signers nft.Signers) bool {
+	sns := signers.Signers()
+	for i := range sns {
+		if sns[i].Signed() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (it MintItem) Collection() extensioncurrency.ContractID {
 	return it.collection
 }
 
 func (it MintItem) Addresses() ([]base.Address, error) {
-	return it.form.Addresses()
+	as, err := it.form.Addresses()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(as, it.receivers...), nil
 }
 
 func (it MintItem) Form() MintForm {
 	return it.form
 }
 
+func (it MintItem) Receivers() []base.Address {
+	return it.receivers
+}
+
+// Idxes returns the idxes chosen by minter. Empty means the nfts take the next
+// idxes of the collection counter.
+func (it MintItem) Idxes() []uint64 {
+	return it.idxes
+}
+
+// Count returns the number of nfts minted by item.
+func (it MintItem) Count() int {
+	if len(it.receivers) < 1 {
+		return 1
+	}
+
+	return len(it.receivers)
+}
+
 func (it MintItem) Currency() currency.CurrencyID {
 	return it.cid
 }
@@ -3,6 +3,7 @@ package collection
 import (
 	"go.mongodb.org/mongo-driver/bson"
 
+	"github.com/spikeekips/mitum/base"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 )
 
@@ -39,15 +40,19 @@ func (it MintItem) MarshalBSON() ([]byte, error) {
 			bson.M{
 				"collection": it.collection,
 				"form":       it.form,
+				"receivers":  it.receivers,
+				"idxes":      it.idxes,
 				"currency":   it.cid,
 			}),
 	)
 }
 
 type MintItemBSONUnpacker struct {
-	CL string   `bson:"collection"`
-	FO bson.Raw `bson:"form"`
-	CR string   `bson:"currency"`
+	CL string                `bson:"collection"`
+	FO bson.Raw              `bson:"form"`
+	RC []base.AddressDecoder `bson:"receivers"`
+	IX []uint64              `bson:"idxes"`
+	CR string                `bson:"currency"`
 }
 
 func (it *MintItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -56,5 +61,5 @@ func (it *MintItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return it.unpack(enc, uit.CL, uit.FO, uit.CR)
+	return it.unpack(enc, uit.CL, uit.FO, uit.RC, uit.IX, uit.CR)
 }
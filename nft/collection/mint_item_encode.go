@@ -5,6 +5,7 @@ import (
 	"github.com/ProtoconNet/mitum-nft/nft"
 
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/encoder"
 )
@@ -42,6 +43,8 @@ func (it *MintItem) unpack(
 	enc encoder.Encoder,
 	collection string,
 	bf []byte,
+	brs []base.AddressDecoder,
+	idxes []uint64,
 	cid string,
 ) error {
 	it.collection = extensioncurrency.ContractID(collection)
@@ -54,6 +57,17 @@ func (it *MintItem) unpack(
 		it.form = form
 	}
 
+	receivers := make([]base.Address, len(brs))
+	for i := range brs {
+		receiver, err := brs[i].Encode(enc)
+		if err != nil {
+			return err
+		}
+		receivers[i] = receiver
+	}
+	it.receivers = receivers
+	it.idxes = idxes
+
 	it.cid = currency.CurrencyID(cid)
 
 	return nil
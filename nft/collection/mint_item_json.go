@@ -7,6 +7,7 @@ import (
 	"github.com/ProtoconNet/mitum-nft/nft"
 
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
 )
 
@@ -48,6 +49,8 @@ type MintItemJSONPacker struct {
 	jsonenc.HintedHead
 	CL extensioncurrency.ContractID `json:"collection"`
 	FO MintForm                     `json:"form"`
+	RC []base.Address               `json:"receivers"`
+	IX []uint64                     `json:"idxes"`
 	CR currency.CurrencyID          `json:"currency"`
 }
 
@@ -56,14 +59,18 @@ func (it MintItem) MarshalJSON() ([]byte, error) {
 		HintedHead: jsonenc.NewHintedHead(it.Hint()),
 		CL:         it.collection,
 		FO:         it.form,
+		RC:         it.receivers,
+		IX:         it.idxes,
 		CR:         it.cid,
 	})
 }
 
 type MintItemJSONUnpacker struct {
-	CL string          `json:"collection"`
-	FO json.RawMessage `json:"form"`
-	CR string          `json:"currency"`
+	CL string                `json:"collection"`
+	FO json.RawMessage       `json:"form"`
+	RC []base.AddressDecoder `json:"receivers"`
+	IX []uint64              `json:"idxes"`
+	CR string                `json:"currency"`
 }
 
 func (it *MintItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -72,5 +79,5 @@ func (it *MintItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return it.unpack(enc, uit.CL, uit.FO, uit.CR)
+	return it.unpack(enc, uit.CL, uit.FO, uit.RC, uit.IX, uit.CR)
 }
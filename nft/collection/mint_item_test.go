@@ -32,14 +32,14 @@ func (t *testMintForm) TestNew() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -48,7 +48,7 @@ func (t *testMintForm) TestNew() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -85,14 +85,14 @@ func (t *testMintForm) TestEmptyNFTHash() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -101,7 +101,7 @@ func (t *testMintForm) TestEmptyNFTHash() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -138,14 +138,14 @@ func (t *testMintForm) TestOverMaxNFTHash() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -155,7 +155,7 @@ func (t *testMintForm) TestOverMaxNFTHash() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -190,14 +190,14 @@ func (t *testMintForm) TestSpaceNFTHash() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -206,7 +206,7 @@ func (t *testMintForm) TestSpaceNFTHash() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -241,14 +241,14 @@ func (t *testMintForm) TestNotTrimmedNFTHash() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -257,7 +257,7 @@ func (t *testMintForm) TestNotTrimmedNFTHash() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -294,14 +294,14 @@ func (t *testMintForm) TestEmptyUri() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -310,7 +310,7 @@ func (t *testMintForm) TestEmptyUri() {
 		"", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -330,8 +330,8 @@ func (t *testMintForm) TestEmptyUri() {
 	mint, err := NewMint(fact, fs, "")
 	t.NoError(err)
 
-	err = mint.IsValid(nil)
-	t.Contains(err.Error(), "empty uri")
+	// NOTE empty uri is checked with the uri template of collection policy
+	t.NoError(mint.IsValid(nil))
 }
 
 func (t *testMintForm) TestOverMaxUri() {
@@ -345,14 +345,14 @@ func (t *testMintForm) TestOverMaxUri() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -362,7 +362,7 @@ func (t *testMintForm) TestOverMaxUri() {
 		nft.URI(uri), creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -397,14 +397,14 @@ func (t *testMintForm) TestSpaceUri() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -413,7 +413,7 @@ func (t *testMintForm) TestSpaceUri() {
 		"           ", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -448,14 +448,14 @@ func (t *testMintForm) TestNotTrimmedUri() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -465,7 +465,7 @@ func (t *testMintForm) TestNotTrimmedUri() {
 		nft.URI(uri), creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -503,8 +503,8 @@ func (t *testMintForm) TestEmptyCreators() {
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -513,7 +513,7 @@ func (t *testMintForm) TestEmptyCreators() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -548,23 +548,23 @@ func (t *testMintForm) TestOverMaxCreators() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(MustAddress(util.UUID().String()), 0, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
+			nft.NewSigner(MustAddress(util.UUID().String()), 0, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -573,7 +573,7 @@ func (t *testMintForm) TestOverMaxCreators() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -609,8 +609,8 @@ func (t *testMintForm) TestEmptyCopyrighters() {
 	)
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 
@@ -619,7 +619,7 @@ func (t *testMintForm) TestEmptyCopyrighters() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -654,23 +654,23 @@ func (t *testMintForm) TestOverMaxCopyrighters() {
 
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(MustAddress(util.UUID().String()), 0, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
-			nft.NewSigner(MustAddress(util.UUID().String()), 10, false),
+			nft.NewSigner(MustAddress(util.UUID().String()), 0, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
+			nft.NewSigner(MustAddress(util.UUID().String()), 10, false, nil, nil),
 		},
 	)
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 
@@ -679,7 +679,7 @@ func (t *testMintForm) TestOverMaxCopyrighters() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -714,14 +714,14 @@ func (t *testMintForm) TestWrongCreatorsTotal() {
 
 	creators := nft.NewSigners(
 		90, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -730,7 +730,7 @@ func (t *testMintForm) TestWrongCreatorsTotal() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -765,14 +765,14 @@ func (t *testMintForm) TestWrongCopyrightersTotal() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		90, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -781,7 +781,7 @@ func (t *testMintForm) TestWrongCopyrightersTotal() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -816,14 +816,14 @@ func (t *testMintForm) TestZeroCreatorsTotal() {
 
 	creators := nft.NewSigners(
 		0, []nft.Signer{
-			nft.NewSigner(creator0, 0, false),
-			nft.NewSigner(creator1, 0, false),
+			nft.NewSigner(creator0, 0, false, nil, nil),
+			nft.NewSigner(creator1, 0, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -832,7 +832,7 @@ func (t *testMintForm) TestZeroCreatorsTotal() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -869,14 +869,14 @@ func (t *testMintForm) TestZeroCopyrightersTotal() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		0, []nft.Signer{
-			nft.NewSigner(copyrighter0, 0, false),
-			nft.NewSigner(copyrighter1, 0, false),
+			nft.NewSigner(copyrighter0, 0, false, nil, nil),
+			nft.NewSigner(copyrighter1, 0, false, nil, nil),
 		},
 	)
 
@@ -885,7 +885,7 @@ func (t *testMintForm) TestZeroCopyrightersTotal() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -922,14 +922,14 @@ func (t *testMintForm) TestOverMaxCreatorsTotal() {
 
 	creators := nft.NewSigners(
 		120, []nft.Signer{
-			nft.NewSigner(creator0, 60, false),
-			nft.NewSigner(creator1, 60, false),
+			nft.NewSigner(creator0, 60, false, nil, nil),
+			nft.NewSigner(creator1, 60, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -938,7 +938,7 @@ func (t *testMintForm) TestOverMaxCreatorsTotal() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -973,14 +973,14 @@ func (t *testMintForm) TestOverMaxCopyrightersTotal() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		120, []nft.Signer{
-			nft.NewSigner(copyrighter0, 60, false),
-			nft.NewSigner(copyrighter1, 60, false),
+			nft.NewSigner(copyrighter0, 60, false, nil, nil),
+			nft.NewSigner(copyrighter1, 60, false, nil, nil),
 		},
 	)
 
@@ -989,7 +989,7 @@ func (t *testMintForm) TestOverMaxCopyrightersTotal() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -1024,15 +1024,15 @@ func (t *testMintForm) TestDuplicateCreator() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 30, false),
-			nft.NewSigner(creator0, 30, false),
-			nft.NewSigner(creator1, 40, false),
+			nft.NewSigner(creator0, 30, false, nil, nil),
+			nft.NewSigner(creator0, 30, false, nil, nil),
+			nft.NewSigner(creator1, 40, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -1041,7 +1041,7 @@ func (t *testMintForm) TestDuplicateCreator() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -1076,15 +1076,15 @@ func (t *testMintForm) TestDuplicateCopyrighter() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 30, false),
-			nft.NewSigner(copyrighter1, 30, false),
-			nft.NewSigner(copyrighter1, 40, false),
+			nft.NewSigner(copyrighter0, 30, false, nil, nil),
+			nft.NewSigner(copyrighter1, 30, false, nil, nil),
+			nft.NewSigner(copyrighter1, 40, false, nil, nil),
 		},
 	)
 
@@ -1093,7 +1093,7 @@ func (t *testMintForm) TestDuplicateCopyrighter() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -1132,14 +1132,14 @@ func testMintFormEncode(enc encoder.Encoder) suite.TestingSuite {
 
 		creators := nft.NewSigners(
 			100, []nft.Signer{
-				nft.NewSigner(creator0, 50, false),
-				nft.NewSigner(creator1, 50, false),
+				nft.NewSigner(creator0, 50, false, nil, nil),
+				nft.NewSigner(creator1, 50, false, nil, nil),
 			},
 		)
 		copyrighters := nft.NewSigners(
 			100, []nft.Signer{
-				nft.NewSigner(copyrighter0, 50, false),
-				nft.NewSigner(copyrighter1, 50, false),
+				nft.NewSigner(copyrighter0, 50, false, nil, nil),
+				nft.NewSigner(copyrighter1, 50, false, nil, nil),
 			},
 		)
 
@@ -1148,7 +1148,7 @@ func testMintFormEncode(enc encoder.Encoder) suite.TestingSuite {
 			"https://localhost:5000/nft", creators, copyrighters,
 		)
 		items := []MintItem{
-			NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+			NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 		}
 		fact := NewMintFact(token, sender, items)
 
@@ -1230,14 +1230,14 @@ func (t *testMintItem) TestNew() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -1246,7 +1246,7 @@ func (t *testMintItem) TestNew() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -1272,8 +1272,48 @@ func (t *testMintItem) TestNew() {
 	t.Implements((*operation.Operation)(nil), mint)
 }
 
+func (t *testMintItem) TestDuplicateReceivers() {
+	receiver := MustAddress(util.UUID().String())
+
+	form := NewMintForm(
+		nft.NFTHash(nft.NewTestNFTID(1).Hash().String()),
+		"https://localhost:5000/nft", nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}),
+	)
+	item := NewMintItem(extensioncurrency.ContractID("ABC"), form, []base.Address{receiver, receiver}, nil, "MCC")
+
+	err := item.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "duplicate receiver")
+}
+
+func (t *testMintItem) TestSignedSignerWithReceivers() {
+	creator := MustAddress(util.UUID().String())
+
+	form := NewMintForm(
+		nft.NFTHash(nft.NewTestNFTID(1).Hash().String()),
+		"https://localhost:5000/nft", nft.NewSigners(10, []nft.Signer{nft.NewSigner(creator, 10, true, nil, nil)}), nft.NewSigners(0, []nft.Signer{}),
+	)
+	item := NewMintItem(extensioncurrency.ContractID("ABC"), form, []base.Address{MustAddress(util.UUID().String()), MustAddress(util.UUID().String())}, nil, "MCC")
+
+	err := item.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "not allowed with multiple receivers")
+}
+
+func (t *testMintItem) TestIdxesNotMatched() {
+	form := NewMintForm(
+		nft.NFTHash(nft.NewTestNFTID(1).Hash().String()),
+		"https://localhost:5000/nft", nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}),
+	)
+	item := NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, []uint64{1, 2}, "MCC")
+
+	err := item.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "idxes not matched")
+}
+
 func TestMintItem(t *testing.T) {
-	suite.Run(t, new(testSignItem))
+	suite.Run(t, new(testMintItem))
 }
 
 func testMintItemEncode(enc encoder.Encoder) suite.TestingSuite {
@@ -1291,14 +1331,14 @@ func testMintItemEncode(enc encoder.Encoder) suite.TestingSuite {
 
 		creators := nft.NewSigners(
 			100, []nft.Signer{
-				nft.NewSigner(creator0, 50, false),
-				nft.NewSigner(creator1, 50, false),
+				nft.NewSigner(creator0, 50, false, nil, nil),
+				nft.NewSigner(creator1, 50, false, nil, nil),
 			},
 		)
 		copyrighters := nft.NewSigners(
 			100, []nft.Signer{
-				nft.NewSigner(copyrighter0, 50, false),
-				nft.NewSigner(copyrighter1, 50, false),
+				nft.NewSigner(copyrighter0, 50, false, nil, nil),
+				nft.NewSigner(copyrighter1, 50, false, nil, nil),
 			},
 		)
 
@@ -1306,8 +1346,12 @@ func testMintItemEncode(enc encoder.Encoder) suite.TestingSuite {
 			nft.NFTHash(nft.NewTestNFTID(1).Hash().String()),
 			"https://localhost:5000/nft", creators, copyrighters,
 		)
+		receivers := []base.Address{
+			MustAddress(util.UUID().String()),
+			MustAddress(util.UUID().String()),
+		}
 		items := []MintItem{
-			NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+			NewMintItem(extensioncurrency.ContractID("ABC"), form, receivers, []uint64{3, 4}, "MCC"),
 		}
 		fact := NewMintFact(token, sender, items)
 
@@ -1348,6 +1392,11 @@ func testMintItemEncode(enc encoder.Encoder) suite.TestingSuite {
 
 			t.Equal(a.Collection(), b.Collection())
 			t.Equal(a.Currency(), b.Currency())
+			t.Equal(len(a.Receivers()), len(b.Receivers()))
+			for j := range a.Receivers() {
+				t.True(a.Receivers()[j].Equal(b.Receivers()[j]))
+			}
+			t.Equal(a.Idxes(), b.Idxes())
 
 			af := a.Form()
 			bf := b.Form()
@@ -33,14 +33,18 @@ func (Mint) Process(
 }
 
 type MintItemProcessor struct {
-	cp     *extensioncurrency.CurrencyPool
-	h      valuehash.Hash
-	idx    uint64
-	box    *NFTBox
-	nft    nft.NFT
-	nst    state.State
-	sender base.Address
-	item   MintItem
+	cp        *extensioncurrency.CurrencyPool
+	networkID base.NetworkID
+	h         valuehash.Hash
+	idx       uint64
+	box       *NFTBox
+	policy    CollectionPolicy
+	nft       nft.NFT
+	nst       state.State
+	holdings  *NFTBox
+	sender    base.Address
+	receiver  base.Address
+	item      MintItem
 }
 
 func (ipp *MintItemProcessor) PreProcess(
@@ -56,24 +60,57 @@ func (ipp *MintItemProcessor) PreProcess(
 		return err
 	}
 
+	if limit := ipp.policy.Limit(); ipp.idx > limit {
+		return errors.Errorf("nft idx over max of collection; %d > %d", ipp.idx, limit)
+	}
+
 	if st, err := notExistsState(StateKeyNFT(id), "nft", getState); err != nil {
 		return err
 	} else {
 		ipp.nst = st
 	}
 
+	if !ipp.receiver.Equal(ipp.sender) {
+		if err := checkExistsState(currency.StateKeyAccount(ipp.receiver), getState); err != nil {
+			return err
+		}
+	}
+
 	form := ipp.item.Form()
+	if ha := ipp.policy.HashAlgorithm(); ha != "" && form.NftHash().Algorithm() != ha {
+		return errors.Errorf("nft hash must be tagged with collection hash algorithm, %q; %q", ha, form.NftHash())
+	}
+
+	uri := form.Uri()
+	if len(uri) < 1 {
+		if !ipp.policy.Uri().IsTemplate() {
+			return errors.Errorf("empty uri; collection has no uri template, %q", ipp.item.Collection())
+		}
+
+		uri = ipp.policy.Uri().Resolve(id)
+	}
+
+	if len(ipp.policy.Schemes()) > 0 {
+		if !ipp.policy.AllowsScheme(uri.Scheme()) {
+			return errors.Errorf("uri scheme not allowed in collection, %v; %q", ipp.policy.Schemes(), uri)
+		}
+
+		if err := uri.CheckScheme(); err != nil {
+			return err
+		}
+	}
+
 	if form.Creators().Total() != 0 {
 		creators := form.Creators().Signers()
 		for i := range creators {
 			creator := creators[i].Account()
 			if err := checkExistsState(currency.StateKeyAccount(creator), getState); err != nil {
 				return err
-			} else if err = checkNotExistsState(extensioncurrency.StateKeyContractAccount(creator), getState); err != nil {
-				return errors.Errorf("contract account cannot be a creator; %q", creator)
 			}
 			if creators[i].Signed() {
-				return errors.Errorf("Cannot sign at the same time as minting; %q", creator)
+				if err := checkSignedAtMinting(creators[i], ipp.networkID, id, form.NftHash(), uri, getState); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -84,16 +121,16 @@ func (ipp *MintItemProcessor) PreProcess(
 			copyrighter := copyrighters[i].Account()
 			if err := checkExistsState(currency.StateKeyAccount(copyrighter), getState); err != nil {
 				return err
-			} else if err = checkNotExistsState(extensioncurrency.StateKeyContractAccount(copyrighter), getState); err != nil {
-				return errors.Errorf("contract account cannot be a copyrighter; %q", copyrighter)
 			}
 			if copyrighters[i].Signed() {
-				return errors.Errorf("Cannot sign at the same time as minting; %q", copyrighter)
+				if err := checkSignedAtMinting(copyrighters[i], ipp.networkID, id, form.NftHash(), uri, getState); err != nil {
+					return err
+				}
 			}
 		}
 	}
 
-	n := nft.NewNFT(id, true, ipp.sender, form.NftHash(), form.Uri(), ipp.sender, form.Creators(), form.Copyrighters())
+	n := nft.NewNFT(id, true, ipp.receiver, form.NftHash(), uri, ipp.receiver, form.Creators(), form.Copyrighters())
 	if err := n.IsValid(nil); err != nil {
 		return operation.NewBaseReasonError(err.Error())
 	}
@@ -102,6 +139,33 @@ func (ipp *MintItemProcessor) PreProcess(
 	return nil
 }
 
+// checkSignedAtMinting checks the signer marked signed at minting attests the
+// nft id, nft hash and resolved uri with the key of its account. The signer
+// of the sequential nft id signs the expected next id, so the attestation
+// fails if the other mint takes the id first.
+func checkSignedAtMinting(
+	signer nft.Signer,
+	networkID base.NetworkID,
+	id nft.NFTID,
+	h nft.NFTHash,
+	uri nft.URI,
+	getState func(key string) (state.State, bool, error),
+) error {
+	if !signer.Attested() {
+		return errors.Errorf("signer signed at minting must have attestation; %q", signer.Account())
+	}
+
+	if err := checkAttestationKey(signer.Account(), signer.Publickey(), getState); err != nil {
+		return err
+	}
+
+	if err := signer.VerifyAttestation(networkID, id, h, uri); err != nil {
+		return errors.Wrapf(err, "failed to verify attestation; %q", signer.Account())
+	}
+
+	return nil
+}
+
 func (ipp *MintItemProcessor) Process(
 	_ func(key string) (state.State, bool, error),
 	_ func(valuehash.Hash, ...state.State) error,
@@ -113,6 +177,10 @@ func (ipp *MintItemProcessor) Process(
 		return nil, err
 	}
 
+	if err := ipp.holdings.Append(ipp.nft.ID()); err != nil {
+		return nil, err
+	}
+
 	if st, err := SetStateNFTValue(ipp.nst, ipp.nft); err != nil {
 		return nil, err
 	} else {
@@ -124,12 +192,16 @@ func (ipp *MintItemProcessor) Process(
 
 func (ipp *MintItemProcessor) Close() error {
 	ipp.cp = nil
+	ipp.networkID = nil
 	ipp.h = nil
 	ipp.idx = 0
 	ipp.box = nil
+	ipp.policy = CollectionPolicy{}
 	ipp.nft = nft.NFT{}
 	ipp.nst = nil
+	ipp.holdings = nil
 	ipp.sender = nil
+	ipp.receiver = nil
 	ipp.item = MintItem{}
 	MintItemProcessorPool.Put(ipp)
 
@@ -137,18 +209,25 @@ func (ipp *MintItemProcessor) Close() error {
 }
 
 type MintProcessor struct {
-	cp *extensioncurrency.CurrencyPool
+	cp        *extensioncurrency.CurrencyPool
+	networkID base.NetworkID
 	Mint
 	ipps         []*MintItemProcessor
 	idxes        map[extensioncurrency.ContractID]uint64
 	idxStates    map[extensioncurrency.ContractID]state.State
-	boxes        map[extensioncurrency.ContractID]*NFTBox
-	boxStates    map[extensioncurrency.ContractID]state.State
+	boxes        map[string]*NFTBox
+	boxStates    map[string]state.State
+	holdings     *holdingsPool
+	hashes       map[string]*NFTBox
+	hashStates   map[string]state.State
+	indexes      map[extensioncurrency.ContractID]uint64
+	indexStates  map[extensioncurrency.ContractID]state.State
+	policies     map[extensioncurrency.ContractID]CollectionPolicy
 	amountStates map[currency.CurrencyID]currency.AmountState
 	required     map[currency.CurrencyID][2]currency.Big
 }
 
-func NewMintProcessor(cp *extensioncurrency.CurrencyPool) currency.GetNewProcessor {
+func NewMintProcessor(cp *extensioncurrency.CurrencyPool, networkID base.NetworkID) currency.GetNewProcessor {
 	return func(op state.Processor) (state.Processor, error) {
 		i, ok := op.(Mint)
 		if !ok {
@@ -158,12 +237,19 @@ func NewMintProcessor(cp *extensioncurrency.CurrencyPool) currency.GetNewProcess
 		opp := MintProcessorPool.Get().(*MintProcessor)
 
 		opp.cp = cp
+		opp.networkID = networkID
 		opp.Mint = i
 		opp.ipps = nil
 		opp.idxes = nil
 		opp.idxStates = nil
 		opp.boxes = nil
 		opp.boxStates = nil
+		opp.holdings = nil
+		opp.hashes = nil
+		opp.hashStates = nil
+		opp.indexes = nil
+		opp.indexStates = nil
+		opp.policies = nil
 		opp.amountStates = nil
 		opp.required = nil
 
@@ -188,18 +274,20 @@ func (opp *MintProcessor) PreProcess(
 		return nil, operation.NewBaseReasonError(err.Error())
 	}
 
-	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getState); err != nil {
-		return nil, operation.NewBaseReasonError("contract account cannot mint nfts; %q", fact.Sender())
-	}
-
-	if err := checkFactSignsByState(fact.Sender(), opp.Signs(), getState); err != nil {
+	if err := checkFactSignsByAccounts(fact.signingAccounts(), opp.Signs(), getState); err != nil {
 		return nil, operation.NewBaseReasonError("invalid signing; %w", err)
 	}
 
 	opp.idxes = map[extensioncurrency.ContractID]uint64{}
 	opp.idxStates = map[extensioncurrency.ContractID]state.State{}
-	opp.boxes = map[extensioncurrency.ContractID]*NFTBox{}
-	opp.boxStates = map[extensioncurrency.ContractID]state.State{}
+	opp.boxes = map[string]*NFTBox{}
+	opp.boxStates = map[string]state.State{}
+	opp.holdings = newHoldingsPool()
+	opp.hashes = map[string]*NFTBox{}
+	opp.hashStates = map[string]state.State{}
+	opp.indexes = map[extensioncurrency.ContractID]uint64{}
+	opp.indexStates = map[extensioncurrency.ContractID]state.State{}
+	opp.policies = map[extensioncurrency.ContractID]CollectionPolicy{}
 	for i := range fact.items {
 		collection := fact.items[i].Collection()
 
@@ -229,6 +317,7 @@ func (opp *MintProcessor) PreProcess(
 						return nil, operation.NewBaseReasonError("sender is not whitelisted; %q", fact.Sender())
 					}
 				}
+				opp.policies[collection] = policy
 			}
 
 			if st, err := existsState(StateKeyCollectionLastIDX(collection), "collection idx", getState); err != nil {
@@ -239,49 +328,110 @@ func (opp *MintProcessor) PreProcess(
 				opp.idxes[collection] = idx
 				opp.idxStates[collection] = st
 			}
-		}
 
-		if _, found := opp.boxes[collection]; !found {
-			var box NFTBox
-			switch st, found, err := getState(StateKeyNFTs(collection)); {
-			case err != nil:
+			if err := opp.indexNFTHashes(collection, getState); err != nil {
 				return nil, operation.NewBaseReasonError(err.Error())
-			case !found:
-				box = NewNFTBox(nil)
-				opp.boxStates[collection] = st
-			default:
-				b, err := StateNFTsValue(st)
-				if err != nil {
-					return nil, operation.NewBaseReasonError(err.Error())
-				}
-				box = b
-				opp.boxStates[collection] = st
 			}
-			opp.boxes[collection] = &box
 		}
 	}
 
-	ipps := make([]*MintItemProcessor, len(fact.items))
+	var ipps []*MintItemProcessor
+	chosen := map[string]struct{}{}
 	for i := range fact.items {
 		collection := fact.items[i].Collection()
-		idx := opp.idxes[collection] + 1
-		opp.idxes[collection] = idx
-
-		c := MintItemProcessorPool.Get().(*MintItemProcessor)
-		c.cp = opp.cp
-		c.h = opp.Hash()
-		c.idx = idx
-		c.box = opp.boxes[collection]
-		c.nft = nft.NFT{}
-		c.nst = nil
-		c.sender = fact.Sender()
-		c.item = fact.items[i]
-
-		if err := c.PreProcess(getState, setState); err != nil {
-			return nil, operation.NewBaseReasonError(err.Error())
+		policy := opp.policies[collection]
+
+		idxes := fact.items[i].Idxes()
+		switch {
+		case policy.MinterIdx() && len(idxes) < 1:
+			return nil, operation.NewBaseReasonError("idxes must be given; minters choose nft idx in collection, %q", collection)
+		case !policy.MinterIdx() && len(idxes) > 0:
+			return nil, operation.NewBaseReasonError("minters cannot choose nft idx in collection, %q", collection)
+		}
+
+		receivers := fact.items[i].Receivers()
+		if len(receivers) < 1 {
+			receivers = []base.Address{fact.Sender()}
 		}
 
-		ipps[i] = c
+		// NOTE airdrop mints the nfts of one nft hash, so it can not be minted
+		// with hash uniqueness.
+		h := fact.items[i].Form().NftHash()
+		if uniqueness := policy.HashUniqueness(); len(uniqueness) > 0 && len(h) > 0 {
+			if h.Algorithm() == "" {
+				return nil, operation.NewBaseReasonError("nft hash must be tagged with hash algorithm for hash uniqueness; %q", h)
+			}
+
+			if len(receivers) > 1 {
+				return nil, operation.NewBaseReasonError("airdrop of one nft hash not allowed with hash uniqueness, %q", uniqueness)
+			}
+		}
+
+		for j := range receivers {
+			var idx uint64
+			if policy.MinterIdx() {
+				idx = idxes[j]
+
+				id := nft.NewNFTID(collection, idx).String()
+				if _, found := chosen[id]; found {
+					return nil, operation.NewBaseReasonError("duplicate nft idx found in mint; %q", id)
+				}
+				chosen[id] = struct{}{}
+
+				if idx > opp.idxes[collection] {
+					opp.idxes[collection] = idx
+				}
+			} else {
+				idx = opp.idxes[collection] + 1
+				opp.idxes[collection] = idx
+			}
+
+			key := StateKeyNFTsPage(collection, NFTBoxPage(idx))
+			if _, found := opp.boxes[key]; !found {
+				box, st, err := loadNFTBoxPage(nft.NewNFTID(collection, idx), getState)
+				if err != nil {
+					return nil, operation.NewBaseReasonError(err.Error())
+				}
+				opp.boxes[key] = &box
+				opp.boxStates[key] = st
+			}
+
+			if len(h) > 0 {
+				id := nft.NewNFTID(collection, idx)
+				uniqueness := policy.HashUniqueness()
+				if err := opp.appendNFTHash(StateKeyCollectionNFTHash(collection, h), id, uniqueness == CollectionHashUniqueness, getState); err != nil {
+					return nil, operation.NewBaseReasonError(err.Error())
+				}
+				if err := opp.appendNFTHash(StateKeyNFTHash(h), id, uniqueness == GlobalHashUniqueness, getState); err != nil {
+					return nil, operation.NewBaseReasonError(err.Error())
+				}
+			}
+
+			holdings, err := opp.holdings.load(receivers[j], collection, getState)
+			if err != nil {
+				return nil, operation.NewBaseReasonError(err.Error())
+			}
+
+			c := MintItemProcessorPool.Get().(*MintItemProcessor)
+			c.cp = opp.cp
+			c.networkID = opp.networkID
+			c.h = opp.Hash()
+			c.idx = idx
+			c.box = opp.boxes[key]
+			c.policy = policy
+			c.nft = nft.NFT{}
+			c.nst = nil
+			c.holdings = holdings
+			c.sender = fact.Sender()
+			c.receiver = receivers[j]
+			c.item = fact.items[i]
+
+			if err := c.PreProcess(getState, setState); err != nil {
+				return nil, operation.NewBaseReasonError(err.Error())
+			}
+
+			ipps = append(ipps, c)
+		}
 	}
 
 	if required, err := opp.calculateItemsFee(); err != nil {
@@ -293,7 +443,7 @@ func (opp *MintProcessor) PreProcess(
 		opp.amountStates = sts
 	}
 
-	if err := checkFactSignsByState(fact.Sender(), opp.Signs(), getState); err != nil {
+	if err := checkFactSignsByAccounts(fact.signingAccounts(), opp.Signs(), getState); err != nil {
 		return nil, operation.NewBaseReasonError("invalid signing; %w", err)
 	}
 
@@ -329,8 +479,30 @@ func (opp *MintProcessor) Process(
 		}
 	}
 
-	for c, box := range opp.boxes {
-		if st, err := SetStateNFTsValue(opp.boxStates[c], *box); err != nil {
+	for k, box := range opp.boxes {
+		if st, err := SetStateNFTsValue(opp.boxStates[k], *box); err != nil {
+			return operation.NewBaseReasonError(err.Error())
+		} else {
+			states = append(states, st)
+		}
+	}
+
+	if sts, err := opp.holdings.States(); err != nil {
+		return operation.NewBaseReasonError(err.Error())
+	} else {
+		states = append(states, sts...)
+	}
+
+	for k, box := range opp.hashes {
+		if st, err := SetStateNFTsValue(opp.hashStates[k], *box); err != nil {
+			return operation.NewBaseReasonError(err.Error())
+		} else {
+			states = append(states, st)
+		}
+	}
+
+	for c, n := range opp.indexes {
+		if st, err := SetStateNFTHashIndexValue(opp.indexStates[c], n); err != nil {
 			return operation.NewBaseReasonError(err.Error())
 		} else {
 			states = append(states, st)
@@ -345,18 +517,114 @@ func (opp *MintProcessor) Process(
 	return setState(fact.Hash(), states...)
 }
 
+// appendNFTHash adds id to the nfts minted with the nft hash of key. If unique,
+// no active nft must be minted with the nft hash, in state or in this mint.
+func (opp *MintProcessor) appendNFTHash(
+	key string,
+	id nft.NFTID,
+	unique bool,
+	getState func(key string) (state.State, bool, error),
+) error {
+	box, err := opp.nftHashBox(key, getState)
+	if err != nil {
+		return err
+	}
+
+	if unique && !box.IsEmpty() {
+		return errors.Errorf("nft hash already minted; %q", box.NFTs()[0])
+	}
+
+	return box.Append(id)
+}
+
+func (opp *MintProcessor) nftHashBox(
+	key string,
+	getState func(key string) (state.State, bool, error),
+) (*NFTBox, error) {
+	if box, found := opp.hashes[key]; found {
+		return box, nil
+	}
+
+	box, st, err := loadNFTHashBox(key, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.hashes[key] = &box
+	opp.hashStates[key] = st
+
+	return &box, nil
+}
+
+// indexNFTHashes indexes the active nfts of the legacy nft box of collection by
+// nft hash, once at the first mint of collection; the nft hash index of
+// collection marks it. The nfts minted after nft hashes were indexed are
+// indexed at minting.
+func (opp *MintProcessor) indexNFTHashes(
+	collection extensioncurrency.ContractID,
+	getState func(key string) (state.State, bool, error),
+) error {
+	ist, found, err := getState(StateKeyNFTHashIndex(collection))
+	switch {
+	case err != nil:
+		return err
+	case found:
+		return nil
+	}
+
+	legacy, nfts, err := loadLegacyNFTs(collection, getState)
+	switch {
+	case err != nil:
+		return err
+	case legacy.IsEmpty():
+		return nil
+	}
+
+	for i := range nfts {
+		h := nfts[i].NftHash()
+		if len(h) < 1 {
+			continue
+		}
+
+		for _, key := range []string{StateKeyCollectionNFTHash(collection, h), StateKeyNFTHash(h)} {
+			box, err := opp.nftHashBox(key, getState)
+			if err != nil {
+				return err
+			}
+
+			if !box.Exists(nfts[i].ID()) {
+				if err := box.Append(nfts[i].ID()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	opp.indexes[collection] = uint64(len(legacy.NFTs()))
+	opp.indexStates[collection] = ist
+
+	return nil
+}
+
 func (opp *MintProcessor) Close() error {
 	for i := range opp.ipps {
 		_ = opp.ipps[i].Close()
 	}
 
 	opp.cp = nil
+	opp.networkID = nil
 	opp.Mint = Mint{}
 	opp.ipps = nil
 	opp.idxes = nil
 	opp.idxStates = nil
 	opp.boxes = nil
 	opp.boxStates = nil
+	opp.holdings = nil
+	opp.hashes = nil
+	opp.hashStates = nil
+	opp.indexes = nil
+	opp.indexStates = nil
+	opp.policies = nil
 	opp.amountStates = nil
 	opp.required = nil
 
@@ -406,7 +674,10 @@ func CalculateMintItemsFee(cp *extensioncurrency.CurrencyPool, items []MintItem)
 		case !k.OverZero():
 			required[it.Currency()] = [2]currency.Big{rq[0], rq[1]}
 		default:
-			required[it.Currency()] = [2]currency.Big{rq[0].Add(k), rq[1].Add(k)}
+			for j := 0; j < it.Count(); j++ {
+				rq = [2]currency.Big{rq[0].Add(k), rq[1].Add(k)}
+			}
+			required[it.Currency()] = rq
 		}
 
 	}
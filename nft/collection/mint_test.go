@@ -30,14 +30,14 @@ func (t *testMint) TestNew() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -46,7 +46,7 @@ func (t *testMint) TestNew() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -103,14 +103,14 @@ func (t *testMint) TestOverMaxItems() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -129,17 +129,17 @@ func (t *testMint) TestOverMaxItems() {
 	}
 
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), forms[0], "MCC"),
-		NewMintItem(extensioncurrency.ContractID("ABC"), forms[1], "MCC"),
-		NewMintItem(extensioncurrency.ContractID("ABC"), forms[2], "MCC"),
-		NewMintItem(extensioncurrency.ContractID("ABC"), forms[3], "MCC"),
-		NewMintItem(extensioncurrency.ContractID("ABC"), forms[4], "MCC"),
-		NewMintItem(extensioncurrency.ContractID("ABC"), forms[5], "MCC"),
-		NewMintItem(extensioncurrency.ContractID("ABC"), forms[6], "MCC"),
-		NewMintItem(extensioncurrency.ContractID("ABC"), forms[7], "MCC"),
-		NewMintItem(extensioncurrency.ContractID("ABC"), forms[8], "MCC"),
-		NewMintItem(extensioncurrency.ContractID("ABC"), forms[9], "MCC"),
-		NewMintItem(extensioncurrency.ContractID("ABC"), forms[10], "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), forms[0], nil, nil, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), forms[1], nil, nil, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), forms[2], nil, nil, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), forms[3], nil, nil, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), forms[4], nil, nil, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), forms[5], nil, nil, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), forms[6], nil, nil, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), forms[7], nil, nil, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), forms[8], nil, nil, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), forms[9], nil, nil, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), forms[10], nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
@@ -167,14 +167,14 @@ func (t *testMint) TestOverSizeMemo() {
 
 	creators := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(creator0, 50, false),
-			nft.NewSigner(creator1, 50, false),
+			nft.NewSigner(creator0, 50, false, nil, nil),
+			nft.NewSigner(creator1, 50, false, nil, nil),
 		},
 	)
 	copyrighters := nft.NewSigners(
 		100, []nft.Signer{
-			nft.NewSigner(copyrighter0, 50, false),
-			nft.NewSigner(copyrighter1, 50, false),
+			nft.NewSigner(copyrighter0, 50, false, nil, nil),
+			nft.NewSigner(copyrighter1, 50, false, nil, nil),
 		},
 	)
 
@@ -183,7 +183,7 @@ func (t *testMint) TestOverSizeMemo() {
 		"https://localhost:5000/nft", creators, copyrighters,
 	)
 	items := []MintItem{
-		NewMintItem(extensioncurrency.ContractID("ABC"), form, "MCC"),
+		NewMintItem(extensioncurrency.ContractID("ABC"), form, nil, nil, "MCC"),
 	}
 	fact := NewMintFact(token, sender, items)
 
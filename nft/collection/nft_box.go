@@ -116,9 +116,6 @@ func (nbx *NFTBox) Append(n nft.NFTID) error {
 	if nbx.Exists(n) {
 		return errors.Errorf("nft %v already exists in nft box", n)
 	}
-	if uint64(len(nbx.nfts)) >= nft.MaxNFTIdx {
-		return errors.Errorf("max nfts in collection; %v", n)
-	}
 	nbx.nfts = append(nbx.nfts, n)
 	return nil
 }
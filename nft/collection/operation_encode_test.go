@@ -60,6 +60,12 @@ func (t *baseTestEncode) SetupSuite() {
 	t.encs.TestAddHinter(DelegateFactHinter)
 	t.encs.TestAddHinter(DelegateItemHinter)
 	t.encs.TestAddHinter(DelegateHinter)
+	t.encs.TestAddHinter(ReplaceSignerFactHinter)
+	t.encs.TestAddHinter(ReplaceSignerItemHinter)
+	t.encs.TestAddHinter(ReplaceSignerHinter)
+	t.encs.TestAddHinter(TransferShareFactHinter)
+	t.encs.TestAddHinter(TransferShareItemHinter)
+	t.encs.TestAddHinter(TransferShareHinter)
 	t.encs.TestAddHinter(nft.NFTHinter)
 	t.encs.TestAddHinter(nft.NFTIDHinter)
 	t.encs.TestAddHinter(nft.DesignHinter)
@@ -118,6 +118,19 @@ func (opr *OperationProcessor) setState(op valuehash.Hash, sts ...state.State) e
 	return opr.pool.Set(op, sts...)
 }
 
+// Fees returns the fees charged by the operations processed so far.
+func (opr *OperationProcessor) Fees() map[currency.CurrencyID]currency.Big {
+	opr.RLock()
+	defer opr.RUnlock()
+
+	fees := make(map[currency.CurrencyID]currency.Big, len(opr.fee))
+	for cid := range opr.fee {
+		fees[cid] = opr.fee[cid]
+	}
+
+	return fees
+}
+
 func (opr *OperationProcessor) PreProcess(op state.Processor) (state.Processor, error) {
 	var sp state.Processor
 	switch i, known, err := opr.getNewProcessor(op); {
@@ -158,7 +171,9 @@ func (opr *OperationProcessor) Process(op state.Processor) error {
 		*MintProcessor,
 		*TransferProcessor,
 		*BurnProcessor,
-		*SignProcessor:
+		*SignProcessor,
+		*ReplaceSignerProcessor,
+		*TransferShareProcessor:
 		return opr.process(op)
 	case currency.Transfers,
 		currency.CreateAccounts,
@@ -175,7 +190,9 @@ func (opr *OperationProcessor) Process(op state.Processor) error {
 		Mint,
 		Transfer,
 		Burn,
-		Sign:
+		Sign,
+		ReplaceSigner,
+		TransferShare:
 		pr, err := opr.PreProcess(op)
 		if err != nil {
 			return err
@@ -216,6 +233,10 @@ func (opr *OperationProcessor) process(op state.Processor) error {
 		sp = t
 	case *SignProcessor:
 		sp = t
+	case *ReplaceSignerProcessor:
+		sp = t
+	case *TransferShareProcessor:
+		sp = t
 	default:
 		return op.Process(opr.pool.Get, opr.pool.Set)
 	}
@@ -283,6 +304,12 @@ func (opr *OperationProcessor) checkDuplication(op state.Processor) error { // n
 	case Sign:
 		did = t.Fact().(SignFact).Sender().String()
 		didtype = DuplicationTypeSender
+	case ReplaceSigner:
+		did = t.Fact().(ReplaceSignerFact).Sender().String()
+		didtype = DuplicationTypeSender
+	case TransferShare:
+		did = t.Fact().(TransferShareFact).Sender().String()
+		didtype = DuplicationTypeSender
 	default:
 		return nil
 	}
@@ -377,7 +404,9 @@ func (opr *OperationProcessor) getNewProcessor(op state.Processor) (state.Proces
 		Mint,
 		Transfer,
 		Burn,
-		Sign:
+		Sign,
+		ReplaceSigner,
+		TransferShare:
 
 		return nil, false, errors.Errorf("%T needs SetProcessor", t)
 	default:
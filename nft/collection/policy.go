@@ -41,6 +41,56 @@ func (cn CollectionName) IsValid([]byte) error {
 	return nil
 }
 
+func isValidURISchemes(schemes []nft.URIScheme) error {
+	if l := len(schemes); l > nft.MaxAllowedURISchemes {
+		return isvalid.InvalidError.Errorf("uri schemes over allowed; %d > %d", l, nft.MaxAllowedURISchemes)
+	}
+
+	founds := map[nft.URIScheme]struct{}{}
+	for i := range schemes {
+		if err := schemes[i].IsValid(nil); err != nil {
+			return err
+		}
+		if _, found := founds[schemes[i]]; found {
+			return isvalid.InvalidError.Errorf("duplicate uri scheme found; %q", schemes[i])
+		}
+		founds[schemes[i]] = struct{}{}
+	}
+
+	return nil
+}
+
+var (
+	CollectionHashUniqueness = HashUniqueness("collection")
+	GlobalHashUniqueness     = HashUniqueness("global")
+)
+
+// HashUniqueness is the scope in which the nft hash of new nft must not be
+// minted to the active nfts. Empty means nft hash need not be unique. The nft
+// hash must be tagged with hash algorithm, and airdrop is not allowed, for the
+// nfts of airdrop share one nft hash.
+//
+// NOTE the nfts minted before nft hashes were indexed are indexed at the first
+// mint of their collection; until then, global uniqueness does not see them.
+type HashUniqueness string
+
+func (hu HashUniqueness) Bytes() []byte {
+	return []byte(hu)
+}
+
+func (hu HashUniqueness) String() string {
+	return string(hu)
+}
+
+func (hu HashUniqueness) IsValid([]byte) error {
+	switch hu {
+	case "", CollectionHashUniqueness, GlobalHashUniqueness:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown hash uniqueness; %q", hu)
+	}
+}
+
 var (
 	CollectionPolicyType   = hint.Type("mitum-nft-collection-policy")
 	CollectionPolicyHint   = hint.NewHint(CollectionPolicyType, "v0.0.1")
@@ -49,24 +99,54 @@ var (
 
 type CollectionPolicy struct {
 	hint.BaseHinter
-	name    CollectionName
-	royalty nft.PaymentParameter
-	uri     nft.URI
-	whites  []base.Address
+	name          CollectionName
+	royalty       nft.PaymentParameter
+	uri           nft.URI
+	whites        []base.Address
+	hashAlgorithm nft.HashAlgorithm
+	schemes       []nft.URIScheme
+	minterIdx     bool
+	limit         uint64
+	uniqueness    HashUniqueness
 }
 
-func NewCollectionPolicy(name CollectionName, royalty nft.PaymentParameter, uri nft.URI, whites []base.Address) CollectionPolicy {
+func NewCollectionPolicy(
+	name CollectionName,
+	royalty nft.PaymentParameter,
+	uri nft.URI,
+	whites []base.Address,
+	hashAlgorithm nft.HashAlgorithm,
+	schemes []nft.URIScheme,
+	minterIdx bool,
+	limit uint64,
+	uniqueness HashUniqueness,
+) CollectionPolicy {
 	return CollectionPolicy{
-		BaseHinter: hint.NewBaseHinter(CollectionPolicyHint),
-		name:       name,
-		royalty:    royalty,
-		uri:        uri,
-		whites:     whites,
+		BaseHinter:    hint.NewBaseHinter(CollectionPolicyHint),
+		name:          name,
+		royalty:       royalty,
+		uri:           uri,
+		whites:        whites,
+		hashAlgorithm: hashAlgorithm,
+		schemes:       schemes,
+		minterIdx:     minterIdx,
+		limit:         limit,
+		uniqueness:    uniqueness,
 	}
 }
 
-func MustNewCollectionPolicy(name CollectionName, royalty nft.PaymentParameter, uri nft.URI, whites []base.Address) CollectionPolicy {
-	policy := NewCollectionPolicy(name, royalty, uri, whites)
+func MustNewCollectionPolicy(
+	name CollectionName,
+	royalty nft.PaymentParameter,
+	uri nft.URI,
+	whites []base.Address,
+	hashAlgorithm nft.HashAlgorithm,
+	schemes []nft.URIScheme,
+	minterIdx bool,
+	limit uint64,
+	uniqueness HashUniqueness,
+) CollectionPolicy {
+	policy := NewCollectionPolicy(name, royalty, uri, whites, hashAlgorithm, schemes, minterIdx, limit, uniqueness)
 
 	if err := policy.IsValid(nil); err != nil {
 		panic(err)
@@ -81,12 +161,32 @@ func (policy CollectionPolicy) Bytes() []byte {
 		as[i] = policy.whites[i].Bytes()
 	}
 
-	return util.ConcatBytesSlice(
+	ss := make([][]byte, len(policy.schemes))
+	for i := range policy.schemes {
+		ss[i] = policy.schemes[i].Bytes()
+	}
+
+	bs := [][]byte{
 		policy.name.Bytes(),
 		policy.royalty.Bytes(),
 		policy.uri.Bytes(),
 		util.ConcatBytesSlice(as...),
-	)
+		policy.hashAlgorithm.Bytes(),
+		util.ConcatBytesSlice(ss...),
+		policy.uniqueness.Bytes(),
+	}
+
+	// NOTE minterIdx and limit are left out unless set, so the bytes of the
+	// policy made before them keep the same.
+	if policy.minterIdx {
+		bs = append(bs, util.BoolToBytes(policy.minterIdx))
+	}
+
+	if policy.limit > 0 {
+		bs = append(bs, util.Uint64ToBytes(policy.limit))
+	}
+
+	return util.ConcatBytesSlice(bs...)
 }
 
 func (policy CollectionPolicy) IsValid([]byte) error {
@@ -97,6 +197,10 @@ func (policy CollectionPolicy) IsValid([]byte) error {
 		return err
 	}
 
+	if err := policy.uri.CheckTemplate(); err != nil {
+		return err
+	}
+
 	if l := len(policy.whites); l > MaxWhiteAddress {
 		return isvalid.InvalidError.Errorf("address in white list over allowed; %d > %d", l, MaxWhiteAddress)
 	}
@@ -113,7 +217,21 @@ func (policy CollectionPolicy) IsValid([]byte) error {
 		founds[acc] = struct{}{}
 	}
 
-	return nil
+	if policy.hashAlgorithm != "" {
+		if err := policy.hashAlgorithm.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if err := policy.uniqueness.IsValid(nil); err != nil {
+		return err
+	}
+
+	if policy.limit > nft.MaxNFTIdxLimit {
+		return isvalid.InvalidError.Errorf("limit over allowed; %d > %d", policy.limit, nft.MaxNFTIdxLimit)
+	}
+
+	return isValidURISchemes(policy.schemes)
 }
 
 func (policy CollectionPolicy) Name() CollectionName {
@@ -132,6 +250,57 @@ func (policy CollectionPolicy) Whites() []base.Address {
 	return policy.whites
 }
 
+// HashAlgorithm returns the hash algorithm which the nft hash of new nfts
+// must be tagged with. Empty means no requirement.
+func (policy CollectionPolicy) HashAlgorithm() nft.HashAlgorithm {
+	return policy.hashAlgorithm
+}
+
+// Schemes returns the uri schemes allowed for new nfts. Empty means any scheme
+// is allowed.
+func (policy CollectionPolicy) Schemes() []nft.URIScheme {
+	return policy.schemes
+}
+
+// MinterIdx reports whether minters choose the idx of new nfts instead of the
+// collection counter.
+//
+// NOTE string external ids, like the serial numbers of physical goods, are not
+// supported; nft id is collection symbol and uint64 idx, which every state key
+// and nft address is built on. Serial numbers must be numeric, or be mapped
+// to idx by the minter.
+func (policy CollectionPolicy) MinterIdx() bool {
+	return policy.minterIdx
+}
+
+// Limit returns the max idx of nfts in collection. nft.MaxNFTIdx is the limit
+// if policy does not set one.
+func (policy CollectionPolicy) Limit() uint64 {
+	if policy.limit == 0 {
+		return nft.MaxNFTIdx
+	}
+
+	return policy.limit
+}
+
+func (policy CollectionPolicy) HashUniqueness() HashUniqueness {
+	return policy.uniqueness
+}
+
+func (policy CollectionPolicy) AllowsScheme(scheme nft.URIScheme) bool {
+	if len(policy.schemes) < 1 {
+		return true
+	}
+
+	for i := range policy.schemes {
+		if policy.schemes[i] == scheme {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (policy CollectionPolicy) Addresses() ([]base.Address, error) {
 	as := make([]base.Address, len(policy.whites))
 	for i := range policy.whites {
@@ -158,6 +327,32 @@ func (policy CollectionPolicy) Equal(c nft.BasePolicy) bool {
 		return false
 	}
 
+	if policy.hashAlgorithm != cpolicy.hashAlgorithm {
+		return false
+	}
+
+	if policy.minterIdx != cpolicy.minterIdx {
+		return false
+	}
+
+	if policy.limit != cpolicy.limit {
+		return false
+	}
+
+	if policy.uniqueness != cpolicy.uniqueness {
+		return false
+	}
+
+	if len(policy.schemes) != len(cpolicy.schemes) {
+		return false
+	}
+
+	for i := range policy.schemes {
+		if policy.schemes[i] != cpolicy.schemes[i] {
+			return false
+		}
+	}
+
 	if len(policy.whites) != len(cpolicy.whites) {
 		return false
 	}
@@ -11,10 +11,15 @@ func (p CollectionPolicy) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(bsonenc.MergeBSONM(
 		bsonenc.NewHintedDoc(p.Hint()),
 		bson.M{
-			"name":    p.name,
-			"royalty": p.royalty,
-			"uri":     p.uri,
-			"whites":  p.whites,
+			"name":            p.name,
+			"royalty":         p.royalty,
+			"uri":             p.uri,
+			"whites":          p.whites,
+			"hash_algorithm":  p.hashAlgorithm,
+			"schemes":         p.schemes,
+			"minter_idx":      p.minterIdx,
+			"limit":           p.limit,
+			"hash_uniqueness": p.uniqueness,
 		},
 	))
 }
@@ -24,6 +29,11 @@ type PolicyBSONUnpacker struct {
 	RY uint                  `bson:"royalty"`
 	UR string                `bson:"uri"`
 	WH []base.AddressDecoder `bson:"whites"`
+	HA string                `bson:"hash_algorithm"`
+	SC []string              `bson:"schemes"`
+	MI bool                  `bson:"minter_idx"`
+	LM uint64                `bson:"limit"`
+	HU string                `bson:"hash_uniqueness"`
 }
 
 func (p *CollectionPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -32,5 +42,5 @@ func (p *CollectionPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return p.unpack(enc, up.NM, up.RY, up.UR, up.WH)
+	return p.unpack(enc, up.NM, up.RY, up.UR, up.WH, up.HA, up.SC, up.MI, up.LM, up.HU)
 }
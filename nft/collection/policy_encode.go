@@ -13,6 +13,11 @@ func (p *CollectionPolicy) unpack(
 	royalty uint,
 	uri string,
 	bws []base.AddressDecoder,
+	hashAlgorithm string,
+	schemes []string,
+	minterIdx bool,
+	limit uint64,
+	uniqueness string,
 ) error {
 	p.name = CollectionName(name)
 	p.royalty = nft.PaymentParameter(royalty)
@@ -27,6 +32,16 @@ func (p *CollectionPolicy) unpack(
 		}
 	}
 	p.whites = whites
+	p.hashAlgorithm = nft.HashAlgorithm(hashAlgorithm)
+
+	ss := make([]nft.URIScheme, len(schemes))
+	for i := range schemes {
+		ss[i] = nft.URIScheme(schemes[i])
+	}
+	p.schemes = ss
+	p.minterIdx = minterIdx
+	p.limit = limit
+	p.uniqueness = HashUniqueness(uniqueness)
 
 	return nil
 }
@@ -13,6 +13,11 @@ type CollectionPolicyJSONPacker struct {
 	RY nft.PaymentParameter `json:"royalty"`
 	UR nft.URI              `json:"uri"`
 	WH []base.Address       `json:"whites"`
+	HA nft.HashAlgorithm    `json:"hash_algorithm"`
+	SC []nft.URIScheme      `json:"schemes"`
+	MI bool                 `json:"minter_idx"`
+	LM uint64               `json:"limit"`
+	HU HashUniqueness       `json:"hash_uniqueness"`
 }
 
 func (p CollectionPolicy) MarshalJSON() ([]byte, error) {
@@ -22,6 +27,11 @@ func (p CollectionPolicy) MarshalJSON() ([]byte, error) {
 		RY:         p.royalty,
 		UR:         p.uri,
 		WH:         p.whites,
+		HA:         p.hashAlgorithm,
+		SC:         p.schemes,
+		MI:         p.minterIdx,
+		LM:         p.limit,
+		HU:         p.uniqueness,
 	})
 }
 
@@ -30,6 +40,11 @@ type CollectionPolicyJSONUnpacker struct {
 	RY uint                  `json:"royalty"`
 	UR string                `json:"uri"`
 	WH []base.AddressDecoder `json:"whites"`
+	HA string                `json:"hash_algorithm"`
+	SC []string              `json:"schemes"`
+	MI bool                  `json:"minter_idx"`
+	LM uint64                `json:"limit"`
+	HU string                `json:"hash_uniqueness"`
 }
 
 func (p *CollectionPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -38,5 +53,5 @@ func (p *CollectionPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return p.unpack(enc, up.NM, up.RY, up.UR, up.WH)
+	return p.unpack(enc, up.NM, up.RY, up.UR, up.WH, up.HA, up.SC, up.MI, up.LM, up.HU)
 }
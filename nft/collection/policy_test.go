@@ -9,6 +9,7 @@ import (
 	"github.com/ProtoconNet/mitum-nft/nft"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/encoder"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
@@ -20,7 +21,7 @@ type testCollectionPolicy struct {
 }
 
 func (t *testCollectionPolicy) newCollectionPolicy(name CollectionName, royalty nft.PaymentParameter, uri nft.URI, whites []base.Address) CollectionPolicy {
-	return MustNewCollectionPolicy(name, royalty, uri, whites)
+	return MustNewCollectionPolicy(name, royalty, uri, whites, "", nil, false, 0, "")
 }
 
 func (t *testCollectionPolicy) TestNew() {
@@ -35,39 +36,47 @@ func (t *testCollectionPolicy) TestNew() {
 }
 
 func (t *testCollectionPolicy) TestShortName() {
-	policy := NewCollectionPolicy("Co", 0, "https://localhost:5000/collection", []base.Address{nft.NewTestAddress()})
+	policy := NewCollectionPolicy("Co", 0, "https://localhost:5000/collection", []base.Address{nft.NewTestAddress()}, "", nil, false, 0, "")
 	t.True(len(policy.Name()) == 2)
 	t.Error(policy.IsValid(nil))
 }
 
 func (t *testCollectionPolicy) TestOverMaxName() {
 	name := strings.Repeat("a", MaxLengthCollectionName+1)
-	policy := NewCollectionPolicy(CollectionName(name), 0, "https://localhost:5000/collection", []base.Address{nft.NewTestAddress()})
+	policy := NewCollectionPolicy(CollectionName(name), 0, "https://localhost:5000/collection", []base.Address{nft.NewTestAddress()}, "", nil, false, 0, "")
 	t.True(len(policy.name) == MaxLengthCollectionName+1)
 	t.Error(policy.IsValid(nil))
 }
 
 func (t *testCollectionPolicy) TestEmptyUri() {
-	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{nft.NewTestAddress()})
+	policy := NewCollectionPolicy("Collection", 0, "", []base.Address{nft.NewTestAddress()}, "", nil, false, 0, "")
 	t.Empty(policy.Uri())
 	t.NoError(policy.IsValid(nil))
 }
 
+func (t *testCollectionPolicy) TestUnknownUriTemplate() {
+	policy := NewCollectionPolicy("Collection", 0, "https://localhost:5000/{base}/{idx}.json", []base.Address{nft.NewTestAddress()}, "", nil, false, 0, "")
+
+	err := policy.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "unknown uri template placeholder")
+}
+
 func (t *testCollectionPolicy) TestOverMaxUri() {
 	uri := strings.Repeat("a", nft.MaxURILength+1)
-	policy := NewCollectionPolicy("Collection", 0, nft.URI(uri), []base.Address{nft.NewTestAddress()})
+	policy := NewCollectionPolicy("Collection", 0, nft.URI(uri), []base.Address{nft.NewTestAddress()}, "", nil, false, 0, "")
 	t.True(len(policy.Uri()) == nft.MaxURILength+1)
 	t.Error(policy.IsValid(nil))
 }
 
 func (t *testCollectionPolicy) TestOverMaxRoyalty() {
-	policy := NewCollectionPolicy("Collection", nft.PaymentParameter(nft.MaxPaymentParameter+1), "https://localhost:5000/collection", []base.Address{nft.NewTestAddress()})
+	policy := NewCollectionPolicy("Collection", nft.PaymentParameter(nft.MaxPaymentParameter+1), "https://localhost:5000/collection", []base.Address{nft.NewTestAddress()}, "", nil, false, 0, "")
 	t.True(policy.Royalty() == nft.PaymentParameter(nft.MaxPaymentParameter+1))
 	t.Error(policy.IsValid(nil))
 }
 
 func (t *testCollectionPolicy) TestEmptyWhites() {
-	policy := NewCollectionPolicy("Collection", 0, "https://localhost:5000/collection", []base.Address{})
+	policy := NewCollectionPolicy("Collection", 0, "https://localhost:5000/collection", []base.Address{}, "", nil, false, 0, "")
 	t.NotNil(policy.Whites())
 	t.Empty(policy.Whites())
 	t.True(len(policy.Whites()) == 0)
@@ -92,11 +101,45 @@ func (t *testCollectionPolicy) TestOverMaxWhites() {
 		nft.NewTestAddress(),
 		nft.NewTestAddress(),
 		nft.NewTestAddress(),
-	})
+	}, "", nil, false, 0, "")
 	t.True(len(policy.Whites()) == MaxWhiteAddress+1)
 	t.Error(policy.IsValid(nil))
 }
 
+func (t *testCollectionPolicy) TestHashAlgorithm() {
+	policy := MustNewCollectionPolicy("Collection", 0, "", []base.Address{}, nft.SHA256HashAlgorithm, nil, false, 0, "")
+	t.NoError(policy.IsValid(nil))
+	t.Equal(nft.SHA256HashAlgorithm, policy.HashAlgorithm())
+
+	policy = NewCollectionPolicy("Collection", 0, "", []base.Address{}, "md5", nil, false, 0, "")
+	t.Error(policy.IsValid(nil))
+}
+
+func (t *testCollectionPolicy) TestSchemes() {
+	policy := MustNewCollectionPolicy("Collection", 0, "", []base.Address{}, "", []nft.URIScheme{nft.IPFSURIScheme, nft.ArweaveURIScheme}, false, 0, "")
+	t.True(policy.AllowsScheme(nft.IPFSURIScheme))
+	t.False(policy.AllowsScheme(nft.HTTPSURIScheme))
+	t.False(policy.AllowsScheme(""))
+
+	policy = MustNewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, "")
+	t.True(policy.AllowsScheme(nft.HTTPSURIScheme))
+
+	policy = NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", []nft.URIScheme{nft.IPFSURIScheme, nft.IPFSURIScheme}, false, 0, "")
+	t.Error(policy.IsValid(nil))
+
+	policy = NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", []nft.URIScheme{"IPFS"}, false, 0, "")
+	t.Error(policy.IsValid(nil))
+}
+
+func (t *testCollectionPolicy) TestHashUniqueness() {
+	policy := MustNewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, GlobalHashUniqueness)
+	t.Equal(GlobalHashUniqueness, policy.HashUniqueness())
+	t.False(policy.Equal(MustNewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, CollectionHashUniqueness)))
+
+	policy = NewCollectionPolicy("Collection", 0, "", []base.Address{}, "", nil, false, 0, "everywhere")
+	t.Error(policy.IsValid(nil))
+}
+
 func (t *testCollectionPolicy) TestEqual() {
 	name := CollectionName("Collection")
 	royalty := nft.PaymentParameter(0)
@@ -124,6 +167,34 @@ func (t *testCollectionPolicy) TestEqual() {
 	t.False(p1.Equal(p6))
 }
 
+func (t *testCollectionPolicy) TestDefaultsNotInBytes() {
+	whites := []base.Address{nft.NewTestAddress()}
+	policy := t.newCollectionPolicy("Collection", 10, "https://localhost:5000/collection", whites)
+
+	t.Equal(util.ConcatBytesSlice(
+		policy.name.Bytes(),
+		policy.royalty.Bytes(),
+		policy.uri.Bytes(),
+		whites[0].Bytes(),
+	), policy.Bytes())
+
+	minterIdx := MustNewCollectionPolicy("Collection", 10, "https://localhost:5000/collection", whites, "", nil, true, 0, "")
+	t.NotEqual(policy.Bytes(), minterIdx.Bytes())
+
+	limit := MustNewCollectionPolicy("Collection", 10, "https://localhost:5000/collection", whites, "", nil, false, 100, "")
+	t.NotEqual(policy.Bytes(), limit.Bytes())
+}
+
+func (t *testCollectionPolicy) TestOverMaxLimit() {
+	policy := NewCollectionPolicy("Collection", 0, "https://localhost:5000/collection", []base.Address{nft.NewTestAddress()}, "", nil, false, nft.MaxNFTIdxLimit, "")
+	t.NoError(policy.IsValid(nil))
+
+	policy = NewCollectionPolicy("Collection", 0, "https://localhost:5000/collection", []base.Address{nft.NewTestAddress()}, "", nil, false, nft.MaxNFTIdxLimit+1, "")
+	err := policy.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "limit over allowed")
+}
+
 type testCollectionPolicyEncode struct {
 	suite.Suite
 	enc encoder.Encoder
@@ -142,7 +213,7 @@ func (t *testCollectionPolicyEncode) SetupSuite() {
 }
 
 func (t *testCollectionPolicyEncode) TestMarshal() {
-	policy := NewCollectionPolicy("Collection", 0, "https://localhost:5000/collection", []base.Address{nft.NewTestAddress()})
+	policy := NewCollectionPolicy("Collection", 0, "https://localhost:5000/collection", []base.Address{nft.NewTestAddress()}, "", nil, false, 0, CollectionHashUniqueness)
 	t.NoError(policy.IsValid(nil))
 
 	b, err := t.enc.Marshal(policy)
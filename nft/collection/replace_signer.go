@@ -0,0 +1,158 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ReplaceSignerFactType   = hint.Type("mitum-nft-replace-signer-operation-fact")
+	ReplaceSignerFactHint   = hint.NewHint(ReplaceSignerFactType, "v0.0.1")
+	ReplaceSignerFactHinter = ReplaceSignerFact{BaseHinter: hint.NewBaseHinter(ReplaceSignerFactHint)}
+	ReplaceSignerType       = hint.Type("mitum-nft-replace-signer-operation")
+	ReplaceSignerHint       = hint.NewHint(ReplaceSignerType, "v0.0.1")
+	ReplaceSignerHinter     = ReplaceSigner{BaseOperation: operationHinter(ReplaceSignerHint)}
+)
+
+var MaxReplaceSignerItems = 10
+
+type ReplaceSignerFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []ReplaceSignerItem
+}
+
+func NewReplaceSignerFact(token []byte, sender base.Address, items []ReplaceSignerItem) ReplaceSignerFact {
+	fact := ReplaceSignerFact{
+		BaseHinter: hint.NewBaseHinter(ReplaceSignerFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ReplaceSignerFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ReplaceSignerFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ReplaceSignerFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact ReplaceSignerFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return isvalid.InvalidError.Errorf("empty items for ReplaceSignerFact")
+	} else if l > int(MaxReplaceSignerItems) {
+		return isvalid.InvalidError.Errorf("items over allowed; %d > %d", l, MaxReplaceSignerItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[nft.NFTID]struct{}{}
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		n := fact.items[i].NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n]; found {
+			return isvalid.InvalidError.Errorf("duplicate nft found; %q", n)
+		}
+
+		founds[n] = struct{}{}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ReplaceSignerFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ReplaceSignerFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ReplaceSignerFact) Items() []ReplaceSignerItem {
+	return fact.items
+}
+
+func (fact ReplaceSignerFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{}
+
+	for i := range fact.items {
+		as = append(as, fact.items[i].Addresses()...)
+	}
+
+	as = append(as, fact.sender)
+
+	return as, nil
+}
+
+func (fact ReplaceSignerFact) Rebuild() ReplaceSignerFact {
+	items := make([]ReplaceSignerItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type ReplaceSigner struct {
+	currency.BaseOperation
+}
+
+func NewReplaceSigner(fact ReplaceSignerFact, fs []base.FactSign, memo string) (ReplaceSigner, error) {
+	bo, err := currency.NewBaseOperationFromFact(ReplaceSignerHint, fact, fs, memo)
+	if err != nil {
+		return ReplaceSigner{}, err
+	}
+
+	return ReplaceSigner{BaseOperation: bo}, nil
+}
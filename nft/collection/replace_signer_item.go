@@ -0,0 +1,90 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	ReplaceSignerItemType   = hint.Type("mitum-nft-replace-signer-item")
+	ReplaceSignerItemHint   = hint.NewHint(ReplaceSignerItemType, "v0.0.1")
+	ReplaceSignerItemHinter = ReplaceSignerItem{BaseHinter: hint.NewBaseHinter(ReplaceSignerItemHint)}
+)
+
+type ReplaceSignerItem struct {
+	hint.BaseHinter
+	qualification Qualification
+	nft           nft.NFTID
+	old           base.Address
+	new           base.Address
+	cid           currency.CurrencyID
+}
+
+func NewReplaceSignerItem(q Qualification, n nft.NFTID, old, nw base.Address, cid currency.CurrencyID) ReplaceSignerItem {
+	return ReplaceSignerItem{
+		BaseHinter:    hint.NewBaseHinter(ReplaceSignerItemHint),
+		qualification: q,
+		nft:           n,
+		old:           old,
+		new:           nw,
+		cid:           cid,
+	}
+}
+
+func (it ReplaceSignerItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.qualification.Bytes(),
+		it.nft.Bytes(),
+		it.old.Bytes(),
+		it.new.Bytes(),
+		it.cid.Bytes(),
+	)
+}
+
+func (it ReplaceSignerItem) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, it.BaseHinter, it.qualification, it.nft, it.old, it.new, it.cid); err != nil {
+		return err
+	}
+
+	if it.qualification.Unsign() {
+		return isvalid.InvalidError.Errorf("invalid qualification for signer replacement; %q", it.qualification)
+	}
+
+	if it.old.Equal(it.new) {
+		return isvalid.InvalidError.Errorf("old signer is same with new signer; %q", it.old)
+	}
+
+	return nil
+}
+
+func (it ReplaceSignerItem) Qualification() Qualification {
+	return it.qualification
+}
+
+func (it ReplaceSignerItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it ReplaceSignerItem) Old() base.Address {
+	return it.old
+}
+
+func (it ReplaceSignerItem) New() base.Address {
+	return it.new
+}
+
+func (it ReplaceSignerItem) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it ReplaceSignerItem) Addresses() []base.Address {
+	return []base.Address{it.old, it.new}
+}
+
+func (it ReplaceSignerItem) Rebuild() ReplaceSignerItem {
+	return it
+}
@@ -0,0 +1,38 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (it ReplaceSignerItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"qualification": it.qualification,
+				"nft":           it.nft,
+				"old":           it.old,
+				"new":           it.new,
+				"currency":      it.cid,
+			}),
+	)
+}
+
+type ReplaceSignerItemBSONUnpacker struct {
+	QU string              `bson:"qualification"`
+	NF bson.Raw            `bson:"nft"`
+	OL base.AddressDecoder `bson:"old"`
+	NW base.AddressDecoder `bson:"new"`
+	CR string              `bson:"currency"`
+}
+
+func (it *ReplaceSignerItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uit ReplaceSignerItemBSONUnpacker
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, uit.QU, uit.NF, uit.OL, uit.NW, uit.CR)
+}
@@ -0,0 +1,46 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *ReplaceSignerItem) unpack(
+	enc encoder.Encoder,
+	q string,
+	bn []byte,
+	bold base.AddressDecoder,
+	bnew base.AddressDecoder,
+	cid string,
+) error {
+
+	it.qualification = Qualification(q)
+
+	if hinter, err := enc.Decode(bn); err != nil {
+		return err
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return util.WrongTypeError.Errorf("not NFTID; %T", hinter)
+	} else {
+		it.nft = n
+	}
+
+	old, err := bold.Encode(enc)
+	if err != nil {
+		return err
+	}
+	it.old = old
+
+	nw, err := bnew.Encode(enc)
+	if err != nil {
+		return err
+	}
+	it.new = nw
+
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type ReplaceSignerItemJSONPacker struct {
+	jsonenc.HintedHead
+	QU Qualification       `json:"qualification"`
+	NF nft.NFTID           `json:"nft"`
+	OL base.Address        `json:"old"`
+	NW base.Address        `json:"new"`
+	CR currency.CurrencyID `json:"currency"`
+}
+
+func (it ReplaceSignerItem) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ReplaceSignerItemJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		QU:         it.qualification,
+		NF:         it.nft,
+		OL:         it.old,
+		NW:         it.new,
+		CR:         it.cid,
+	})
+}
+
+type ReplaceSignerItemJSONUnpacker struct {
+	QU string              `json:"qualification"`
+	NF json.RawMessage     `json:"nft"`
+	OL base.AddressDecoder `json:"old"`
+	NW base.AddressDecoder `json:"new"`
+	CR string              `json:"currency"`
+}
+
+func (it *ReplaceSignerItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uit ReplaceSignerItemJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, uit.QU, uit.NF, uit.OL, uit.NW, uit.CR)
+}
@@ -0,0 +1,153 @@
+package collection
+
+import (
+	"testing"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testReplaceSignerItem struct {
+	suite.Suite
+}
+
+func (t *testReplaceSignerItem) newReplaceSigner(items []ReplaceSignerItem) (ReplaceSigner, error) {
+	sender := MustAddress(util.UUID().String())
+
+	token := util.UUID().Bytes()
+	fact := NewReplaceSignerFact(token, sender, items)
+
+	var fs []base.FactSign
+
+	for _, pk := range []key.Privatekey{
+		key.NewBasePrivatekey(),
+		key.NewBasePrivatekey(),
+		key.NewBasePrivatekey(),
+	} {
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return NewReplaceSigner(fact, fs, "")
+}
+
+func (t *testReplaceSignerItem) TestNew() {
+	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+	old := MustAddress(util.UUID().String())
+	nw := MustAddress(util.UUID().String())
+
+	op, err := t.newReplaceSigner([]ReplaceSignerItem{NewReplaceSignerItem(CreatorQualification, nid, old, nw, "MCC")})
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testReplaceSignerItem) TestUnsignQualification() {
+	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+	old := MustAddress(util.UUID().String())
+	nw := MustAddress(util.UUID().String())
+
+	op, err := t.newReplaceSigner([]ReplaceSignerItem{NewReplaceSignerItem(CreatorUnsignQualification, nid, old, nw, "MCC")})
+	t.NoError(err)
+
+	err = op.IsValid(nil)
+	t.Contains(err.Error(), "invalid qualification")
+}
+
+func (t *testReplaceSignerItem) TestSameSigner() {
+	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+	old := MustAddress(util.UUID().String())
+
+	op, err := t.newReplaceSigner([]ReplaceSignerItem{NewReplaceSignerItem(CopyrighterQualification, nid, old, old, "MCC")})
+	t.NoError(err)
+
+	err = op.IsValid(nil)
+	t.Contains(err.Error(), "old signer is same with new signer")
+}
+
+func TestReplaceSignerItem(t *testing.T) {
+	suite.Run(t, new(testReplaceSignerItem))
+}
+
+func testReplaceSignerItemEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		sender := MustAddress(util.UUID().String())
+
+		token := util.UUID().Bytes()
+		nid0 := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+		nid1 := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 2)
+		items := []ReplaceSignerItem{
+			NewReplaceSignerItem(CreatorQualification, nid0, MustAddress(util.UUID().String()), MustAddress(util.UUID().String()), "MCC"),
+			NewReplaceSignerItem(CopyrighterQualification, nid1, MustAddress(util.UUID().String()), MustAddress(util.UUID().String()), "MCC"),
+		}
+		fact := NewReplaceSignerFact(token, sender, items)
+
+		var fs []base.FactSign
+
+		for _, pk := range []key.Privatekey{
+			key.NewBasePrivatekey(),
+			key.NewBasePrivatekey(),
+			key.NewBasePrivatekey(),
+		} {
+			sig, err := base.NewFactSignature(pk, fact, nil)
+			t.NoError(err)
+
+			fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+		}
+
+		op, err := NewReplaceSigner(fact, fs, "")
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(ReplaceSigner)
+		tb := b.(ReplaceSigner)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(ReplaceSignerFact)
+		ufact := tb.Fact().(ReplaceSignerFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.Equal(len(fact.Items()), len(ufact.Items()))
+
+		for i := range fact.Items() {
+			a := fact.Items()[i]
+			b := ufact.Items()[i]
+
+			t.True(a.NFT().Equal(b.NFT()))
+			t.Equal(a.Qualification(), b.Qualification())
+			t.True(a.Old().Equal(b.Old()))
+			t.True(a.New().Equal(b.New()))
+			t.Equal(a.Currency(), b.Currency())
+		}
+	}
+
+	return t
+}
+
+func TestReplaceSignerItemEncodeJSON(t *testing.T) {
+	suite.Run(t, testReplaceSignerItemEncode(jsonenc.NewEncoder()))
+}
+
+func TestReplaceSignerItemEncodeBSON(t *testing.T) {
+	suite.Run(t, testReplaceSignerItemEncode(bsonenc.NewEncoder()))
+}
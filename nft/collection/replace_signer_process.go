@@ -0,0 +1,332 @@
+package collection
+
+import (
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var ReplaceSignerItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ReplaceSignerItemProcessor)
+	},
+}
+
+var ReplaceSignerProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ReplaceSignerProcessor)
+	},
+}
+
+func (ReplaceSigner) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type ReplaceSignerItemProcessor struct {
+	cp     *extensioncurrency.CurrencyPool
+	h      valuehash.Hash
+	box    SignerReplacementBox
+	bst    state.State
+	sender base.Address
+	item   ReplaceSignerItem
+}
+
+func (ipp *ReplaceSignerItemProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) error {
+	if err := ipp.item.IsValid(nil); err != nil {
+		return err
+	}
+
+	nid := ipp.item.NFT()
+
+	// check collection
+	if st, err := existsState(StateKeyCollection(nid.Collection()), "design", getState); err != nil {
+		return err
+	} else if design, err := StateCollectionValue(st); err != nil {
+		return err
+	} else if !design.Active() {
+		return errors.Errorf("deactivated collection; %q", nid.Collection())
+	} else if cst, err := existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "contract account", getState); err != nil {
+		return err
+	} else if ca, err := extensioncurrency.StateContractAccountValue(cst); err != nil {
+		return err
+	} else if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account; %q", design.Parent())
+	}
+
+	var signers nft.Signers
+
+	// check nft
+	if st, err := existsState(StateKeyNFT(nid), "nft", getState); err != nil {
+		return err
+	} else if nv, err := StateNFTValue(st); err != nil {
+		return err
+	} else if !nv.Active() {
+		return errors.Errorf("burned nft; %q", nid)
+	} else if owner, err := nftAccountOwner(nv, getState); err != nil {
+		return err
+	} else if !owner.Equal(ipp.sender) {
+		return errors.Errorf("sender is not nft owner; %q", ipp.sender)
+	} else {
+		switch ipp.item.Qualification() {
+		case CreatorQualification:
+			signers = nv.Creators()
+		case CopyrighterQualification:
+			signers = nv.Copyrighters()
+		default:
+			return errors.Errorf("wrong qualification; %q", ipp.item.Qualification())
+		}
+	}
+
+	if signers.IndexByAddress(ipp.item.Old()) < 0 {
+		return errors.Errorf("not signer of nft; %q, %q", ipp.item.Old(), nid)
+	}
+
+	if signers.IndexByAddress(ipp.item.New()) >= 0 {
+		return errors.Errorf("new signer is already signer of nft; %q, %q", ipp.item.New(), nid)
+	}
+
+	// check new signer
+	if err := checkExistsState(currency.StateKeyAccount(ipp.item.New()), getState); err != nil {
+		return err
+	}
+
+	switch st, found, err := getState(StateKeySignerReplacement(nid)); {
+	case err != nil:
+		return err
+	case !found:
+		ipp.box = NewSignerReplacementBox(nid, nil)
+		ipp.bst = st
+	default:
+		box, err := StateSignerReplacementValue(st)
+		if err != nil {
+			return err
+		}
+		ipp.box = box
+		ipp.bst = st
+	}
+
+	// NOTE the new signer signs for the first replacement found by it, so the
+	// other old signer can not be replaced by the same new signer.
+	if r, found := ipp.box.Get(ipp.item.Qualification(), ipp.item.New()); found && !r.Old().Equal(ipp.item.Old()) {
+		return errors.Errorf("new signer already proposed for other signer of nft; %q, %q", ipp.item.New(), r.Old())
+	}
+
+	if err := ipp.box.Set(NewSignerReplacement(ipp.item.Qualification(), ipp.item.Old(), ipp.item.New())); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ipp *ReplaceSignerItemProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) ([]state.State, error) {
+
+	var states []state.State
+
+	if st, err := SetStateSignerReplacementValue(ipp.bst, ipp.box); err != nil {
+		return nil, err
+	} else {
+		states = append(states, st)
+	}
+
+	return states, nil
+}
+
+func (ipp *ReplaceSignerItemProcessor) Close() error {
+	ipp.cp = nil
+	ipp.h = nil
+	ipp.box = SignerReplacementBox{}
+	ipp.bst = nil
+	ipp.sender = nil
+	ipp.item = ReplaceSignerItem{}
+	ReplaceSignerItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type ReplaceSignerProcessor struct {
+	cp *extensioncurrency.CurrencyPool
+	ReplaceSigner
+	ipps         []*ReplaceSignerItemProcessor
+	amountStates map[currency.CurrencyID]currency.AmountState
+	required     map[currency.CurrencyID][2]currency.Big
+}
+
+func NewReplaceSignerProcessor(cp *extensioncurrency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(ReplaceSigner)
+		if !ok {
+			return nil, errors.Errorf("not ReplaceSigner; %T", op)
+		}
+
+		opp := ReplaceSignerProcessorPool.Get().(*ReplaceSignerProcessor)
+
+		opp.cp = cp
+		opp.ReplaceSigner = i
+		opp.ipps = nil
+		opp.amountStates = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *ReplaceSignerProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact, ok := opp.Fact().(ReplaceSignerFact)
+	if !ok {
+		return nil, operation.NewBaseReasonError("not ReplaceSignerFact; %T", opp.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return nil, operation.NewBaseReasonError(err.Error())
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getState); err != nil {
+		return nil, operation.NewBaseReasonError(err.Error())
+	}
+
+	if err := checkFactSignsByState(fact.Sender(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing; %w", err)
+	}
+
+	ipps := make([]*ReplaceSignerItemProcessor, len(fact.items))
+	for i := range fact.items {
+
+		c := ReplaceSignerItemProcessorPool.Get().(*ReplaceSignerItemProcessor)
+		c.cp = opp.cp
+		c.h = opp.Hash()
+		c.box = SignerReplacementBox{}
+		c.bst = nil
+		c.sender = fact.Sender()
+		c.item = fact.items[i]
+
+		if err := c.PreProcess(getState, setState); err != nil {
+			return nil, operation.NewBaseReasonError(err.Error())
+		}
+
+		ipps[i] = c
+	}
+
+	opp.ipps = ipps
+
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee; %w", err)
+	} else if sts, err := CheckSenderEnoughBalance(fact.Sender(), required, getState); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee; %w", err)
+	} else {
+		opp.required = required
+		opp.amountStates = sts
+	}
+
+	return opp, nil
+}
+
+func (opp *ReplaceSignerProcessor) Process(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact, ok := opp.Fact().(ReplaceSignerFact)
+	if !ok {
+		return operation.NewBaseReasonError("not ReplaceSignerFact; %T", opp.Fact())
+	}
+
+	var states []state.State
+
+	for i := range opp.ipps {
+		if sts, err := opp.ipps[i].Process(getState, setState); err != nil {
+			return operation.NewBaseReasonError("failed to process replace signer item; %w", err)
+		} else {
+			states = append(states, sts...)
+		}
+	}
+
+	for k := range opp.required {
+		rq := opp.required[k]
+		states = append(states, opp.amountStates[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), states...)
+}
+
+func (opp *ReplaceSignerProcessor) Close() error {
+	for i := range opp.ipps {
+		_ = opp.ipps[i].Close()
+	}
+
+	opp.cp = nil
+	opp.ReplaceSigner = ReplaceSigner{}
+	opp.ipps = nil
+	opp.amountStates = nil
+	opp.required = nil
+
+	ReplaceSignerProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *ReplaceSignerProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact, ok := opp.Fact().(ReplaceSignerFact)
+	if !ok {
+		return nil, errors.Errorf("not ReplaceSignerFact; %T", opp.Fact())
+	}
+
+	items := make([]ReplaceSignerItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateReplaceSignerItemsFee(opp.cp, items)
+}
+
+func CalculateReplaceSignerItemsFee(cp *extensioncurrency.CurrencyPool, items []ReplaceSignerItem) (map[currency.CurrencyID][2]currency.Big, error) {
+	required := map[currency.CurrencyID][2]currency.Big{}
+
+	for i := range items {
+		it := items[i]
+
+		rq := [2]currency.Big{currency.ZeroBig, currency.ZeroBig}
+
+		if k, found := required[it.Currency()]; found {
+			rq = k
+		}
+
+		if cp == nil {
+			required[it.Currency()] = [2]currency.Big{rq[0], rq[1]}
+			continue
+		}
+
+		feeer, found := cp.Feeer(it.Currency())
+		if !found {
+			return nil, errors.Errorf("unknown currency id found; %q", it.Currency())
+		}
+		switch k, err := feeer.Fee(currency.ZeroBig); {
+		case err != nil:
+			return nil, err
+		case !k.OverZero():
+			required[it.Currency()] = [2]currency.Big{rq[0], rq[1]}
+		default:
+			required[it.Currency()] = [2]currency.Big{rq[0].Add(k), rq[1].Add(k)}
+		}
+
+	}
+
+	return required, nil
+}
@@ -0,0 +1,243 @@
+package collection
+
+import (
+	"testing"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testReplaceSignerOperations struct {
+	baseTestOperationProcessor
+	cid    currency.CurrencyID
+	symbol extensioncurrency.ContractID
+}
+
+func (t *testReplaceSignerOperations) SetupSuite() {
+	t.cid = currency.CurrencyID("SHOWME")
+	t.symbol = extensioncurrency.ContractID("SCOLLECT")
+}
+
+func (t *testReplaceSignerOperations) processor(cp *extensioncurrency.CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(ReplaceSignerHinter, NewReplaceSignerProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testReplaceSignerOperations) newReplaceSigner(sender base.Address, keys []key.Privatekey, items []ReplaceSignerItem) ReplaceSigner {
+	token := util.UUID().Bytes()
+	fact := NewReplaceSignerFact(token, sender, items)
+
+	var fs []base.FactSign
+	for _, pk := range keys {
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewReplaceSigner(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+// newStates returns the states of nft owned by owner, of which creators are
+// the given signers.
+func (t *testReplaceSignerOperations) newStates(creators ...base.Address) (*account, nft.NFTID, []state.State) {
+	var sts = []state.State{}
+
+	owner, ost := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, owner.Address)
+	sts = append(sts, ost...)
+	sts = append(sts, pst)
+
+	signers := make([]nft.Signer, len(creators))
+	for i := range creators {
+		signers[i] = nft.NewSigner(creators[i], 10, false, nil, nil)
+	}
+
+	nid := nft.NewNFTID(t.symbol, 1)
+	n := nft.NewNFT(
+		nid,
+		true,
+		owner.Address,
+		"",
+		"https://localhost:5000/nft",
+		owner.Address,
+		nft.NewSigners(uint(10*len(creators)), signers),
+		nft.NewSigners(0, []nft.Signer{}),
+	)
+	sts = append(sts, t.newStateNFT(n))
+
+	_, dst := t.newCollectionDesign(true, parent, owner.Address, []base.Address{owner.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	return owner, nid, sts
+}
+
+func (t *testReplaceSignerOperations) TestPropose() {
+	old, _ := t.newAccount(true, nil)
+	nw, nst := t.newAccount(true, nil)
+
+	owner, nid, sts := t.newStates(old.Address)
+	sts = append(sts, nst...)
+
+	op := t.newReplaceSigner(owner.Address, owner.Privs(), []ReplaceSignerItem{
+		NewReplaceSignerItem(CreatorQualification, nid, old.Address, nw.Address, t.cid),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	t.NoError(opr.Process(op))
+
+	var box SignerReplacementBox
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySignerReplacement(nid) {
+			box, _ = StateSignerReplacementValue(st.GetState())
+		}
+	}
+
+	r, found := box.Get(CreatorQualification, nw.Address)
+	t.True(found)
+	t.True(r.Old().Equal(old.Address))
+}
+
+func (t *testReplaceSignerOperations) TestNotOwner() {
+	old, _ := t.newAccount(true, nil)
+	nw, nst := t.newAccount(true, nil)
+	stranger, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+
+	_, nid, sts := t.newStates(old.Address)
+	sts = append(sts, nst...)
+	sts = append(sts, sst...)
+
+	op := t.newReplaceSigner(stranger.Address, stranger.Privs(), []ReplaceSignerItem{
+		NewReplaceSignerItem(CreatorQualification, nid, old.Address, nw.Address, t.cid),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "sender is not nft owner")
+}
+
+func (t *testReplaceSignerOperations) TestOldNotSigner() {
+	old, _ := t.newAccount(true, nil)
+	nw, nst := t.newAccount(true, nil)
+
+	owner, nid, sts := t.newStates(nft.NewTestAddress())
+	sts = append(sts, nst...)
+
+	op := t.newReplaceSigner(owner.Address, owner.Privs(), []ReplaceSignerItem{
+		NewReplaceSignerItem(CreatorQualification, nid, old.Address, nw.Address, t.cid),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not signer of nft")
+}
+
+func (t *testReplaceSignerOperations) TestNewAlreadySigner() {
+	old, _ := t.newAccount(true, nil)
+	nw, nst := t.newAccount(true, nil)
+
+	owner, nid, sts := t.newStates(old.Address, nw.Address)
+	sts = append(sts, nst...)
+
+	op := t.newReplaceSigner(owner.Address, owner.Privs(), []ReplaceSignerItem{
+		NewReplaceSignerItem(CreatorQualification, nid, old.Address, nw.Address, t.cid),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "new signer is already signer of nft")
+}
+
+func (t *testReplaceSignerOperations) TestNewAlreadyProposed() {
+	old0, _ := t.newAccount(true, nil)
+	old1, _ := t.newAccount(true, nil)
+	nw, nst := t.newAccount(true, nil)
+
+	owner, nid, sts := t.newStates(old0.Address, old1.Address)
+	sts = append(sts, nst...)
+
+	box := NewSignerReplacementBox(nid, []SignerReplacement{
+		NewSignerReplacement(CreatorQualification, old0.Address, nw.Address),
+	})
+	value, _ := state.NewHintedValue(box)
+	bst, err := state.NewStateV0(StateKeySignerReplacement(nid), value, base.NilHeight)
+	t.NoError(err)
+	sts = append(sts, bst)
+
+	op := t.newReplaceSigner(owner.Address, owner.Privs(), []ReplaceSignerItem{
+		NewReplaceSignerItem(CreatorQualification, nid, old1.Address, nw.Address, t.cid),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "new signer already proposed for other signer")
+}
+
+func (t *testReplaceSignerOperations) TestNewNotExist() {
+	old, _ := t.newAccount(true, nil)
+	nw, _ := t.newAccount(false, nil)
+
+	owner, nid, sts := t.newStates(old.Address)
+
+	op := t.newReplaceSigner(owner.Address, owner.Privs(), []ReplaceSignerItem{
+		NewReplaceSignerItem(CreatorQualification, nid, old.Address, nw.Address, t.cid),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "does not exist")
+}
+
+func TestReplaceSignerOperations(t *testing.T) {
+	suite.Run(t, new(testReplaceSignerOperations))
+}
@@ -3,14 +3,17 @@ package collection
 import (
 	"github.com/ProtoconNet/mitum-nft/nft"
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/isvalid"
 )
 
 var (
-	CreatorQualification     = Qualification("creator")
-	CopyrighterQualification = Qualification("copyrighter")
+	CreatorQualification           = Qualification("creator")
+	CopyrighterQualification       = Qualification("copyrighter")
+	CreatorUnsignQualification     = Qualification("creator-unsign")
+	CopyrighterUnsignQualification = Qualification("copyrighter-unsign")
 )
 
 type Qualification string
@@ -24,44 +27,119 @@ func (q Qualification) String() string {
 }
 
 func (q Qualification) IsValid([]byte) error {
-	if !(q == CreatorQualification || q == CopyrighterQualification) {
+	switch q {
+	case CreatorQualification, CopyrighterQualification, CreatorUnsignQualification, CopyrighterUnsignQualification:
+		return nil
+	default:
 		return isvalid.InvalidError.Errorf("invalid qualification; %q", q)
 	}
-	return nil
+}
+
+// Unsign checks whether the qualification withdraws the signature.
+func (q Qualification) Unsign() bool {
+	return q == CreatorUnsignQualification || q == CopyrighterUnsignQualification
+}
+
+// Role returns the signers qualification, creator or copyrighter.
+func (q Qualification) Role() Qualification {
+	switch q {
+	case CreatorUnsignQualification:
+		return CreatorQualification
+	case CopyrighterUnsignQualification:
+		return CopyrighterQualification
+	default:
+		return q
+	}
 }
 
 var (
 	SignItemType   = hint.Type("mitum-nft-sign-item")
-	SignItemHint   = hint.NewHint(SignItemType, "v0.0.1")
+	SignItemHint   = hint.NewHint(SignItemType, "v0.0.2")
 	SignItemHinter = SignItem{BaseHinter: hint.NewBaseHinter(SignItemHint)}
+	// LegacySignItemHint is the hint of the sign item made before attestation
+	// was introduced; it has no publickey and signature.
+	LegacySignItemHint = hint.NewHint(SignItemType, "v0.0.1")
 )
 
 type SignItem struct {
 	hint.BaseHinter
 	qualification Qualification
 	nft           nft.NFTID
+	publickey     key.Publickey
+	signature     key.Signature
 	cid           currency.CurrencyID
 }
 
-func NewSignItem(q Qualification, n nft.NFTID, cid currency.CurrencyID) SignItem {
+func NewSignItem(q Qualification, n nft.NFTID, publickey key.Publickey, signature key.Signature, cid currency.CurrencyID) SignItem {
 	return SignItem{
 		BaseHinter:    hint.NewBaseHinter(SignItemHint),
 		qualification: q,
 		nft:           n,
+		publickey:     publickey,
+		signature:     signature,
 		cid:           cid,
 	}
 }
 
 func (it SignItem) Bytes() []byte {
+	if it.IsLegacy() {
+		return util.ConcatBytesSlice(
+			it.qualification.Bytes(),
+			it.nft.Bytes(),
+			it.cid.Bytes(),
+		)
+	}
+
+	var pb []byte
+	if it.publickey != nil {
+		pb = it.publickey.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		it.qualification.Bytes(),
 		it.nft.Bytes(),
+		pb,
+		it.signature.Bytes(),
 		it.cid.Bytes(),
 	)
 }
 
 func (it SignItem) IsValid([]byte) error {
-	return isvalid.Check(nil, false, it.BaseHinter, it.qualification, it.nft, it.cid)
+	if err := isvalid.Check(nil, false, it.BaseHinter, it.qualification, it.nft, it.cid); err != nil {
+		return err
+	}
+
+	if it.IsLegacy() {
+		switch {
+		case it.qualification.Unsign():
+			return isvalid.InvalidError.Errorf("unsign in legacy sign item; %q", it.qualification)
+		case it.publickey != nil || len(it.signature) > 0:
+			return isvalid.InvalidError.Errorf("attestation in legacy sign item")
+		default:
+			return nil
+		}
+	}
+
+	if it.qualification.Unsign() {
+		if it.publickey != nil || len(it.signature) > 0 {
+			return isvalid.InvalidError.Errorf("attestation is not allowed for unsign; %q", it.qualification)
+		}
+
+		return nil
+	}
+
+	if it.publickey == nil {
+		return isvalid.InvalidError.Errorf("empty attestation publickey")
+	}
+
+	return isvalid.Check(nil, false, it.publickey, it.signature)
+}
+
+// IsLegacy checks whether the item has the legacy hint without attestation.
+// The legacy items are only for decoding the operations in the old blocks;
+// they are not processed anymore.
+func (it SignItem) IsLegacy() bool {
+	return it.Hint().Equal(LegacySignItemHint)
 }
 
 func (it SignItem) Qualification() Qualification {
@@ -72,6 +150,16 @@ func (it SignItem) NFT() nft.NFTID {
 	return it.nft
 }
 
+// Publickey returns the account key of sender used for the attestation.
+func (it SignItem) Publickey() key.Publickey {
+	return it.publickey
+}
+
+// Signature returns the attestation signature over nft hash and uri.
+func (it SignItem) Signature() key.Signature {
+	return it.signature
+}
+
 func (it SignItem) Currency() currency.CurrencyID {
 	return it.cid
 }
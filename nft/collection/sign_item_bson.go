@@ -3,6 +3,7 @@ package collection
 import (
 	"go.mongodb.org/mongo-driver/bson"
 
+	"github.com/spikeekips/mitum/base/key"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 )
 
@@ -12,15 +13,19 @@ func (it SignItem) MarshalBSON() ([]byte, error) {
 			bson.M{
 				"qualification": it.qualification,
 				"nft":           it.nft,
+				"publickey":     it.publickey,
+				"signature":     it.signature,
 				"currency":      it.cid,
 			}),
 	)
 }
 
 type SignItemBSONUnpacker struct {
-	QU string   `bson:"qualification"`
-	NF bson.Raw `bson:"nft"`
-	CR string   `bson:"currency"`
+	QU string               `bson:"qualification"`
+	NF bson.Raw             `bson:"nft"`
+	PK key.PublickeyDecoder `bson:"publickey"`
+	SG key.Signature        `bson:"signature"`
+	CR string               `bson:"currency"`
 }
 
 func (it *SignItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -29,5 +34,5 @@ func (it *SignItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return it.unpack(enc, uit.QU, uit.NF, uit.CR)
+	return it.unpack(enc, uit.QU, uit.NF, uit.PK, uit.SG, uit.CR)
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/ProtoconNet/mitum-nft/nft"
 
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/encoder"
 )
@@ -12,6 +13,8 @@ func (it *SignItem) unpack(
 	enc encoder.Encoder,
 	q string,
 	bn []byte,
+	bpk key.PublickeyDecoder,
+	sig key.Signature,
 	cid string,
 ) error {
 
@@ -25,6 +28,13 @@ func (it *SignItem) unpack(
 		it.nft = n
 	}
 
+	pk, err := bpk.Encode(enc)
+	if err != nil {
+		return err
+	}
+	it.publickey = pk
+	it.signature = sig
+
 	it.cid = currency.CurrencyID(cid)
 
 	return nil
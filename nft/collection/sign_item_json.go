@@ -6,6 +6,7 @@ import (
 	"github.com/ProtoconNet/mitum-nft/nft"
 
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base/key"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
 )
 
@@ -13,6 +14,8 @@ type SignItemJSONPacker struct {
 	jsonenc.HintedHead
 	QU Qualification       `json:"qualification"`
 	NF nft.NFTID           `json:"nft"`
+	PK key.Publickey       `json:"publickey"`
+	SG key.Signature       `json:"signature"`
 	CR currency.CurrencyID `json:"currency"`
 }
 
@@ -21,14 +24,18 @@ func (it SignItem) MarshalJSON() ([]byte, error) {
 		HintedHead: jsonenc.NewHintedHead(it.Hint()),
 		QU:         it.qualification,
 		NF:         it.nft,
+		PK:         it.publickey,
+		SG:         it.signature,
 		CR:         it.cid,
 	})
 }
 
 type SignItemJSONUnpacker struct {
-	QU string          `json:"qualification"`
-	NF json.RawMessage `json:"nft"`
-	CR string          `json:"currency"`
+	QU string               `json:"qualification"`
+	NF json.RawMessage      `json:"nft"`
+	PK key.PublickeyDecoder `json:"publickey"`
+	SG key.Signature        `json:"signature"`
+	CR string               `json:"currency"`
 }
 
 func (it *SignItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -37,5 +44,5 @@ func (it *SignItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return it.unpack(enc, uit.QU, uit.NF, uit.CR)
+	return it.unpack(enc, uit.QU, uit.NF, uit.PK, uit.SG, uit.CR)
 }
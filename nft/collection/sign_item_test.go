@@ -5,6 +5,7 @@ import (
 
 	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
 	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/operation"
@@ -12,6 +13,7 @@ import (
 	"github.com/spikeekips/mitum/util/encoder"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -19,13 +21,24 @@ type testSignItem struct {
 	suite.Suite
 }
 
+func newTestSignItem(q Qualification, nid nft.NFTID, cid currency.CurrencyID) SignItem {
+	priv := key.NewBasePrivatekey()
+
+	sig, err := priv.Sign(nft.AttestationBody(testNetworkID, nft.NewTestNFTID(1), nft.NFTHash("sha256:"+util.UUID().String()), "https://localhost:5000/nft"))
+	if err != nil {
+		panic(err)
+	}
+
+	return NewSignItem(q, nid, priv.Publickey(), sig, cid)
+}
+
 // test creator qualification
 func (t *testSignItem) TestNew() {
 	sender := MustAddress(util.UUID().String())
 
 	token := util.UUID().Bytes()
 	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
-	items := []SignItem{NewSignItem(CreatorQualification, nid, "MCC")}
+	items := []SignItem{newTestSignItem(CreatorQualification, nid, "MCC")}
 	fact := NewSignFact(token, sender, items)
 
 	var fs []base.FactSign
@@ -55,7 +68,7 @@ func (t *testSignItem) TestCopyrighterQualification() {
 
 	token := util.UUID().Bytes()
 	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
-	items := []SignItem{NewSignItem(CopyrighterQualification, nid, "MCC")}
+	items := []SignItem{newTestSignItem(CopyrighterQualification, nid, "MCC")}
 	fact := NewSignFact(token, sender, items)
 
 	var fs []base.FactSign
@@ -87,7 +100,7 @@ func (t *testSignItem) TestWrongQualification() {
 
 	token := util.UUID().Bytes()
 	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
-	items := []SignItem{NewSignItem(qualification, nid, "MCC")}
+	items := []SignItem{newTestSignItem(qualification, nid, "MCC")}
 	fact := NewSignFact(token, sender, items)
 
 	var fs []base.FactSign
@@ -115,7 +128,7 @@ func (t *testSignItem) TestZeroIDX() {
 
 	token := util.UUID().Bytes()
 	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 0)
-	items := []SignItem{NewSignItem(CreatorQualification, nid, "MCC")}
+	items := []SignItem{newTestSignItem(CreatorQualification, nid, "MCC")}
 	fact := NewSignFact(token, sender, items)
 
 	var fs []base.FactSign
@@ -138,12 +151,12 @@ func (t *testSignItem) TestZeroIDX() {
 	t.Contains(err.Error(), "nid idx must be over zero")
 }
 
-func (t *testSignItem) TestOverMaxIDX() {
+func (t *testSignItem) TestOverDefaultMaxIDX() {
 	sender := MustAddress(util.UUID().String())
 
 	token := util.UUID().Bytes()
 	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), uint64(nft.MaxNFTIdx)+1)
-	items := []SignItem{NewSignItem(CreatorQualification, nid, "MCC")}
+	items := []SignItem{newTestSignItem(CreatorQualification, nid, "MCC")}
 	fact := NewSignFact(token, sender, items)
 
 	var fs []base.FactSign
@@ -162,8 +175,46 @@ func (t *testSignItem) TestOverMaxIDX() {
 	sign, err := NewSign(fact, fs, "")
 	t.NoError(err)
 
-	err = sign.IsValid(nil)
-	t.Contains(err.Error(), "nid idx over max")
+	t.NoError(sign.IsValid(nil))
+}
+
+func (t *testSignItem) TestEmptyAttestation() {
+	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+
+	item := NewSignItem(CreatorQualification, nid, nil, nil, "MCC")
+	err := item.IsValid(nil)
+	t.Contains(err.Error(), "empty attestation publickey")
+
+	item = NewSignItem(CreatorQualification, nid, key.NewBasePrivatekey().Publickey(), nil, "MCC")
+	err = item.IsValid(nil)
+	t.Contains(err.Error(), "empty Signature")
+}
+
+func (t *testSignItem) TestUnsign() {
+	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+
+	item := NewSignItem(CreatorUnsignQualification, nid, nil, nil, "MCC")
+	t.NoError(item.IsValid(nil))
+
+	item = newTestSignItem(CreatorUnsignQualification, nid, "MCC")
+	err := item.IsValid(nil)
+	t.Contains(err.Error(), "attestation is not allowed for unsign")
+}
+
+func (t *testSignItem) TestLegacy() {
+	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+
+	item := NewSignItem(CreatorQualification, nid, nil, nil, "MCC")
+	item.BaseHinter = hint.NewBaseHinter(LegacySignItemHint)
+
+	t.True(item.IsLegacy())
+	t.NoError(item.IsValid(nil))
+	t.Equal(util.ConcatBytesSlice(CreatorQualification.Bytes(), nid.Bytes(), currency.CurrencyID("MCC").Bytes()), item.Bytes())
+
+	item = newTestSignItem(CreatorQualification, nid, "MCC")
+	item.BaseHinter = hint.NewBaseHinter(LegacySignItemHint)
+	err := item.IsValid(nil)
+	t.Contains(err.Error(), "attestation in legacy sign item")
 }
 
 func TestSignItem(t *testing.T) {
@@ -181,8 +232,8 @@ func testSignItemEncode(enc encoder.Encoder) suite.TestingSuite {
 		nid0 := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
 		nid1 := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 2)
 		items := []SignItem{
-			NewSignItem(CreatorQualification, nid0, "MCC"),
-			NewSignItem(CopyrighterQualification, nid1, "MCC"),
+			newTestSignItem(CreatorQualification, nid0, "MCC"),
+			newTestSignItem(CopyrighterQualification, nid1, "MCC"),
 		}
 		fact := NewSignFact(token, sender, items)
 
@@ -223,6 +274,8 @@ func testSignItemEncode(enc encoder.Encoder) suite.TestingSuite {
 
 			t.True(a.NFT().Equal(b.NFT()))
 			t.Equal(a.Qualification(), b.Qualification())
+			t.True(a.Publickey().Equal(b.Publickey()))
+			t.True(a.Signature().Equal(b.Signature()))
 			t.Equal(a.Currency(), b.Currency())
 		}
 	}
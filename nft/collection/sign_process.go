@@ -33,12 +33,15 @@ func (Sign) Process(
 }
 
 type SignItemProcessor struct {
-	cp     *extensioncurrency.CurrencyPool
-	h      valuehash.Hash
-	nft    nft.NFT
-	nst    state.State
-	sender base.Address
-	item   SignItem
+	cp        *extensioncurrency.CurrencyPool
+	networkID base.NetworkID
+	h         valuehash.Hash
+	nft       nft.NFT
+	nst       state.State
+	box       *SignerReplacementBox
+	bst       state.State
+	sender    base.Address
+	item      SignItem
 }
 
 func (ipp *SignItemProcessor) PreProcess(
@@ -77,7 +80,7 @@ func (ipp *SignItemProcessor) PreProcess(
 	} else if !nv.Active() {
 		return errors.Errorf("burned nft; %q", nid)
 	} else {
-		switch ipp.item.Qualification() {
+		switch ipp.item.Qualification().Role() {
 		case CreatorQualification:
 			signers = nv.Creators()
 		case CopyrighterQualification:
@@ -89,26 +92,77 @@ func (ipp *SignItemProcessor) PreProcess(
 		ipp.nst = st
 	}
 
-	idx := signers.IndexByAddress(ipp.sender)
-	if idx < 0 {
-		return errors.Errorf("not signer of nft; %q, %q", ipp.sender, n.ID())
-	}
+	sns := &signers
 
-	if signers.IsSignedByAddress(ipp.sender) {
-		return errors.Errorf("this signer has already signed nft; %q", ipp.sender)
+	if ipp.item.IsLegacy() {
+		return errors.Errorf("legacy sign item without attestation not allowed; %q", n.ID())
 	}
 
-	signer := nft.NewSigner(signers.Signers()[idx].Account(), signers.Signers()[idx].Share(), true)
-	if err := signer.IsValid(nil); err != nil {
-		return err
+	if !ipp.item.Qualification().Unsign() {
+		if err := checkAttestationKey(ipp.sender, ipp.item.Publickey(), getState); err != nil {
+			return err
+		}
+
+		if err := nft.VerifyAttestation(ipp.item.Publickey(), ipp.item.Signature(), ipp.networkID, n.ID(), n.NftHash(), n.Uri()); err != nil {
+			return errors.Wrapf(err, "failed to verify attestation of nft; %q", n.ID())
+		}
 	}
 
-	sns := &signers
-	if err := sns.SetSigner(signer); err != nil {
-		return err
+	idx := signers.IndexByAddress(ipp.sender)
+	switch {
+	case ipp.item.Qualification().Unsign():
+		if idx < 0 {
+			return errors.Errorf("not signer of nft; %q, %q", ipp.sender, n.ID())
+		}
+
+		if !signers.IsSignedByAddress(ipp.sender) {
+			return errors.Errorf("this signer has not signed nft; %q", ipp.sender)
+		}
+
+		signer := nft.NewSigner(signers.Signers()[idx].Account(), signers.Signers()[idx].Share(), false, nil, nil)
+		if err := sns.SetSigner(signer); err != nil {
+			return err
+		}
+	case idx >= 0:
+		if signers.IsSignedByAddress(ipp.sender) {
+			return errors.Errorf("this signer has already signed nft; %q", ipp.sender)
+		}
+
+		signer := nft.NewSigner(signers.Signers()[idx].Account(), signers.Signers()[idx].Share(), true, ipp.item.Publickey(), ipp.item.Signature())
+		if err := signer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if err := sns.SetSigner(signer); err != nil {
+			return err
+		}
+	default:
+		// NOTE sender may be the new signer of replacement proposed by owner
+		r, err := ipp.replacement(getState)
+		if err != nil {
+			return err
+		}
+
+		oidx := signers.IndexByAddress(r.Old())
+		if oidx < 0 {
+			return errors.Errorf("replaced signer not found in nft; %q, %q", r.Old(), n.ID())
+		}
+
+		signer := nft.NewSigner(ipp.sender, signers.Signers()[oidx].Share(), true, ipp.item.Publickey(), ipp.item.Signature())
+		if err := signer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if err := sns.ReplaceSigner(r.Old(), signer); err != nil {
+			return err
+		}
+
+		if err := ipp.box.Remove(r); err != nil {
+			return err
+		}
 	}
 
-	if ipp.item.Qualification() == CreatorQualification {
+	if ipp.item.Qualification().Role() == CreatorQualification {
 		n = nft.NewNFT(n.ID(), n.Active(), n.Owner(), n.NftHash(), n.Uri(), n.Approved(), *sns, n.Copyrighters())
 	} else {
 		n = nft.NewNFT(n.ID(), n.Active(), n.Owner(), n.NftHash(), n.Uri(), n.Approved(), n.Creators(), *sns)
@@ -122,6 +176,34 @@ func (ipp *SignItemProcessor) PreProcess(
 	return nil
 }
 
+func (ipp *SignItemProcessor) replacement(
+	getState func(key string) (state.State, bool, error),
+) (SignerReplacement, error) {
+	nid := ipp.item.NFT()
+
+	switch st, found, err := getState(StateKeySignerReplacement(nid)); {
+	case err != nil:
+		return SignerReplacement{}, err
+	case !found:
+		return SignerReplacement{}, errors.Errorf("not signer of nft; %q, %q", ipp.sender, nid)
+	default:
+		box, err := StateSignerReplacementValue(st)
+		if err != nil {
+			return SignerReplacement{}, err
+		}
+
+		r, found := box.Get(ipp.item.Qualification().Role(), ipp.sender)
+		if !found {
+			return SignerReplacement{}, errors.Errorf("not signer of nft; %q, %q", ipp.sender, nid)
+		}
+
+		ipp.box = &box
+		ipp.bst = st
+
+		return r, nil
+	}
+}
+
 func (ipp *SignItemProcessor) Process(
 	_ func(key string) (state.State, bool, error),
 	_ func(valuehash.Hash, ...state.State) error,
@@ -135,14 +217,25 @@ func (ipp *SignItemProcessor) Process(
 		states = append(states, st)
 	}
 
+	if ipp.box != nil {
+		if st, err := SetStateSignerReplacementValue(ipp.bst, *ipp.box); err != nil {
+			return nil, err
+		} else {
+			states = append(states, st)
+		}
+	}
+
 	return states, nil
 }
 
 func (ipp *SignItemProcessor) Close() error {
 	ipp.cp = nil
+	ipp.networkID = nil
 	ipp.h = nil
 	ipp.nft = nft.NFT{}
 	ipp.nst = nil
+	ipp.box = nil
+	ipp.bst = nil
 	ipp.sender = nil
 	ipp.item = SignItem{}
 	SignItemProcessorPool.Put(ipp)
@@ -151,14 +244,15 @@ func (ipp *SignItemProcessor) Close() error {
 }
 
 type SignProcessor struct {
-	cp *extensioncurrency.CurrencyPool
+	cp        *extensioncurrency.CurrencyPool
+	networkID base.NetworkID
 	Sign
 	ipps         []*SignItemProcessor
 	amountStates map[currency.CurrencyID]currency.AmountState
 	required     map[currency.CurrencyID][2]currency.Big
 }
 
-func NewSignProcessor(cp *extensioncurrency.CurrencyPool) currency.GetNewProcessor {
+func NewSignProcessor(cp *extensioncurrency.CurrencyPool, networkID base.NetworkID) currency.GetNewProcessor {
 	return func(op state.Processor) (state.Processor, error) {
 		i, ok := op.(Sign)
 		if !ok {
@@ -168,6 +262,7 @@ func NewSignProcessor(cp *extensioncurrency.CurrencyPool) currency.GetNewProcess
 		opp := SignProcessorPool.Get().(*SignProcessor)
 
 		opp.cp = cp
+		opp.networkID = networkID
 		opp.Sign = i
 		opp.ipps = nil
 		opp.amountStates = nil
@@ -194,10 +289,6 @@ func (opp *SignProcessor) PreProcess(
 		return nil, operation.NewBaseReasonError(err.Error())
 	}
 
-	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getState); err != nil {
-		return nil, operation.NewBaseReasonError("contract account cannot sign nfts; %q", fact.Sender())
-	}
-
 	if err := checkFactSignsByState(fact.Sender(), opp.Signs(), getState); err != nil {
 		return nil, operation.NewBaseReasonError("invalid signing; %w", err)
 	}
@@ -207,9 +298,12 @@ func (opp *SignProcessor) PreProcess(
 
 		c := SignItemProcessorPool.Get().(*SignItemProcessor)
 		c.cp = opp.cp
+		c.networkID = opp.networkID
 		c.h = opp.Hash()
 		c.nft = nft.NFT{}
 		c.nst = nil
+		c.box = nil
+		c.bst = nil
 		c.sender = fact.Sender()
 		c.item = fact.items[i]
 
@@ -271,6 +365,7 @@ func (opp *SignProcessor) Close() error {
 	}
 
 	opp.cp = nil
+	opp.networkID = nil
 	opp.Sign = Sign{}
 	opp.ipps = nil
 	opp.amountStates = nil
@@ -16,6 +16,7 @@ import (
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/storage"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
 )
 
 type testSignOperations struct {
@@ -31,7 +32,7 @@ func (t *testSignOperations) SetupSuite() {
 
 func (t *testSignOperations) processor(cp *extensioncurrency.CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
 	copr, err := NewOperationProcessor(cp).
-		SetProcessor(SignHinter, NewSignProcessor(cp))
+		SetProcessor(SignHinter, NewSignProcessor(cp, testNetworkID))
 	t.NoError(err)
 
 	if pool == nil {
@@ -41,8 +42,11 @@ func (t *testSignOperations) processor(cp *extensioncurrency.CurrencyPool, pool
 	return copr.New(pool)
 }
 
-func (t *testSignOperations) newSignItem(q Qualification, nid nft.NFTID, cid currency.CurrencyID) SignItem {
-	return NewSignItem(q, nid, cid)
+func (t *testSignOperations) newSignItem(q Qualification, nid nft.NFTID, h nft.NFTHash, uri nft.URI, priv key.Privatekey, cid currency.CurrencyID) SignItem {
+	sig, err := priv.Sign(nft.AttestationBody(testNetworkID, nid, h, uri))
+	t.NoError(err)
+
+	return NewSignItem(q, nid, priv.Publickey(), sig, cid)
 }
 
 func (t *testSignOperations) newSign(sender base.Address, keys []key.Privatekey, items []SignItem) Sign {
@@ -81,7 +85,7 @@ func (t *testSignOperations) TestSenderNotExist() {
 		"",
 		"https://localhost:5000/nft",
 		sender.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 	nst := t.newStateNFT(n)
@@ -90,7 +94,7 @@ func (t *testSignOperations) TestSenderNotExist() {
 	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
 	sts = append(sts, dst...)
 
-	items := []SignItem{t.newSignItem(CreatorQualification, nid, t.cid)}
+	items := []SignItem{t.newSignItem(CreatorQualification, nid, n.NftHash(), n.Uri(), sender.Privs()[0], t.cid)}
 	signOp := t.newSign(sender.Address, sender.Privs(), items)
 
 	pool, _ := t.statepool(sts)
@@ -122,7 +126,7 @@ func (t *testSignOperations) TestNFTNotExist() {
 	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{}, []nft.NFTID{})
 	sts = append(sts, dst...)
 
-	items := []SignItem{t.newSignItem(CreatorQualification, nid, t.cid)}
+	items := []SignItem{t.newSignItem(CreatorQualification, nid, "", "", sender.Privs()[0], t.cid)}
 	signOp := t.newSign(sender.Address, sender.Privs(), items)
 
 	pool, _ := t.statepool(sts)
@@ -157,7 +161,7 @@ func (t *testSignOperations) TestAlreadySigned() {
 		"",
 		"https://localhost:5000/nft",
 		sender.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, true)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, true, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 	nst := t.newStateNFT(n)
@@ -166,7 +170,7 @@ func (t *testSignOperations) TestAlreadySigned() {
 	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{}, []nft.NFTID{nid})
 	sts = append(sts, dst...)
 
-	items := []SignItem{t.newSignItem(CreatorQualification, nid, t.cid)}
+	items := []SignItem{t.newSignItem(CreatorQualification, nid, n.NftHash(), n.Uri(), sender.Privs()[0], t.cid)}
 	signOp := t.newSign(sender.Address, sender.Privs(), items)
 
 	pool, _ := t.statepool(sts)
@@ -203,7 +207,7 @@ func (t *testSignOperations) TestUnauthorizedSender() {
 		"",
 		"https://localhost:5000/nft",
 		creator.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(creator.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(creator.Address, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 	nst := t.newStateNFT(n)
@@ -212,7 +216,7 @@ func (t *testSignOperations) TestUnauthorizedSender() {
 	_, dst := t.newCollectionDesign(true, parent, creator.Address, []base.Address{creator.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
 	sts = append(sts, dst...)
 
-	items := []SignItem{t.newSignItem(CreatorQualification, nid, t.cid)}
+	items := []SignItem{t.newSignItem(CreatorQualification, nid, n.NftHash(), n.Uri(), sender.Privs()[0], t.cid)}
 	signOp := t.newSign(sender.Address, sender.Privs(), items)
 
 	pool, _ := t.statepool(sts)
@@ -250,7 +254,7 @@ func (t *testSignOperations) TestSignCreator() {
 		"",
 		"https://localhost:5000/nft",
 		sender.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 	nst := t.newStateNFT(n)
@@ -259,7 +263,7 @@ func (t *testSignOperations) TestSignCreator() {
 	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{}, []nft.NFTID{nid})
 	sts = append(sts, dst...)
 
-	items := []SignItem{t.newSignItem(CreatorQualification, nid, t.cid)}
+	items := []SignItem{t.newSignItem(CreatorQualification, nid, n.NftHash(), n.Uri(), sender.Privs()[0], t.cid)}
 	signOp := t.newSign(sender.Address, sender.Privs(), items)
 
 	pool, _ := t.statepool(sts)
@@ -293,6 +297,147 @@ func (t *testSignOperations) TestSignCreator() {
 	t.Equal(fee, amst.(currency.AmountState).Fee())
 
 	t.True(nf.Creators().IsSignedByAddress(sender.Address))
+
+	signer := nf.Creators().Signers()[0]
+	t.True(signer.Attested())
+	t.NoError(signer.VerifyAttestation(testNetworkID, n.ID(), n.NftHash(), n.Uri()))
+
+	err = signer.VerifyAttestation(testNetworkID, n.ID(), n.NftHash(), "https://localhost:5000/other")
+	t.Contains(err.Error(), "invalid attestation")
+}
+
+func (t *testSignOperations) TestWrongAttestation() {
+	var sts = []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+
+	sts = append(sts, pst)
+	sts = append(sts, sst...)
+
+	nid := nft.NewNFTID(t.symbol, 1)
+	n := nft.NewNFT(
+		nid,
+		true,
+		sender.Address,
+		"",
+		"https://localhost:5000/nft",
+		sender.Address,
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
+		nft.NewSigners(0, []nft.Signer{}),
+	)
+	nst := t.newStateNFT(n)
+	sts = append(sts, nst)
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{}, []nft.NFTID{nid})
+	sts = append(sts, dst...)
+
+	items := []SignItem{t.newSignItem(CreatorQualification, nid, n.NftHash(), "https://localhost:5000/other", sender.Privs()[0], t.cid)}
+	signOp := t.newSign(sender.Address, sender.Privs(), items)
+
+	pool, _ := t.statepool(sts)
+	feeer := extensioncurrency.NewFixedFeeer(sender.Address, currency.ZeroBig, currency.ZeroBig)
+
+	cp := extensioncurrency.NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, currency.NewBig(99), nft.NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(signOp)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "invalid attestation")
+}
+
+func (t *testSignOperations) TestLegacyItem() {
+	var sts = []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+
+	sts = append(sts, pst)
+	sts = append(sts, sst...)
+
+	nid := nft.NewNFTID(t.symbol, 1)
+	n := nft.NewNFT(
+		nid,
+		true,
+		sender.Address,
+		"",
+		"https://localhost:5000/nft",
+		sender.Address,
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
+		nft.NewSigners(0, []nft.Signer{}),
+	)
+	nst := t.newStateNFT(n)
+	sts = append(sts, nst)
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{}, []nft.NFTID{nid})
+	sts = append(sts, dst...)
+
+	item := NewSignItem(CreatorQualification, nid, nil, nil, t.cid)
+	item.BaseHinter = hint.NewBaseHinter(LegacySignItemHint)
+	items := []SignItem{item}
+	signOp := t.newSign(sender.Address, sender.Privs(), items)
+
+	pool, _ := t.statepool(sts)
+	feeer := extensioncurrency.NewFixedFeeer(sender.Address, currency.ZeroBig, currency.ZeroBig)
+
+	cp := extensioncurrency.NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, currency.NewBig(99), nft.NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(signOp)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "legacy sign item without attestation not allowed")
+}
+
+func (t *testSignOperations) TestAttestationKeyNotAccountKey() {
+	var sts = []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+
+	sts = append(sts, pst)
+	sts = append(sts, sst...)
+
+	nid := nft.NewNFTID(t.symbol, 1)
+	n := nft.NewNFT(
+		nid,
+		true,
+		sender.Address,
+		"",
+		"https://localhost:5000/nft",
+		sender.Address,
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
+		nft.NewSigners(0, []nft.Signer{}),
+	)
+	nst := t.newStateNFT(n)
+	sts = append(sts, nst)
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{}, []nft.NFTID{nid})
+	sts = append(sts, dst...)
+
+	items := []SignItem{t.newSignItem(CreatorQualification, nid, n.NftHash(), n.Uri(), key.NewBasePrivatekey(), t.cid)}
+	signOp := t.newSign(sender.Address, sender.Privs(), items)
+
+	pool, _ := t.statepool(sts)
+	feeer := extensioncurrency.NewFixedFeeer(sender.Address, currency.ZeroBig, currency.ZeroBig)
+
+	cp := extensioncurrency.NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, currency.NewBig(99), nft.NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(signOp)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "attestation key is not the key of account")
 }
 
 func (t *testSignOperations) TestSignCopyrighter() {
@@ -314,7 +459,7 @@ func (t *testSignOperations) TestSignCopyrighter() {
 		"https://localhost:5000/nft",
 		sender.Address,
 		nft.NewSigners(0, []nft.Signer{}),
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
 	)
 	nst := t.newStateNFT(n)
 	sts = append(sts, nst)
@@ -322,7 +467,7 @@ func (t *testSignOperations) TestSignCopyrighter() {
 	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{}, []nft.NFTID{nid})
 	sts = append(sts, dst...)
 
-	items := []SignItem{t.newSignItem(CopyrighterQualification, nid, t.cid)}
+	items := []SignItem{t.newSignItem(CopyrighterQualification, nid, n.NftHash(), n.Uri(), sender.Privs()[0], t.cid)}
 	signOp := t.newSign(sender.Address, sender.Privs(), items)
 
 	pool, _ := t.statepool(sts)
@@ -377,7 +522,7 @@ func (t *testSignOperations) TestMultipleItemsWithFee() {
 		"",
 		"https://localhost:5000/nft/1",
 		sender.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 	n1 := nft.NewNFT(
@@ -387,7 +532,7 @@ func (t *testSignOperations) TestMultipleItemsWithFee() {
 		"",
 		"https://localhost:5000/nft/2",
 		sender.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 
@@ -411,8 +556,8 @@ func (t *testSignOperations) TestMultipleItemsWithFee() {
 
 	token := util.UUID().Bytes()
 	items := []SignItem{
-		t.newSignItem(CreatorQualification, nid0, t.cid),
-		t.newSignItem(CreatorQualification, nid1, t.cid),
+		t.newSignItem(CreatorQualification, nid0, n0.NftHash(), n0.Uri(), sender.Privs()[0], t.cid),
+		t.newSignItem(CreatorQualification, nid1, n1.NftHash(), n1.Uri(), sender.Privs()[0], t.cid),
 	}
 	fact := NewSignFact(token, sender.Address, items)
 	sig, err := base.NewFactSignature(sender.Privs()[0], fact, nil)
@@ -469,7 +614,7 @@ func (t *testSignOperations) TestInsufficientMultipleItemsWithFee() {
 		"",
 		"https://localhost:5000/nft/1",
 		sender.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 	n1 := nft.NewNFT(
@@ -479,7 +624,7 @@ func (t *testSignOperations) TestInsufficientMultipleItemsWithFee() {
 		"",
 		"https://localhost:5000/nft/2",
 		sender.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 
@@ -502,8 +647,8 @@ func (t *testSignOperations) TestInsufficientMultipleItemsWithFee() {
 
 	token := util.UUID().Bytes()
 	items := []SignItem{
-		t.newSignItem(CreatorQualification, nid0, t.cid),
-		t.newSignItem(CreatorQualification, nid1, t.cid),
+		t.newSignItem(CreatorQualification, nid0, n0.NftHash(), n0.Uri(), sender.Privs()[0], t.cid),
+		t.newSignItem(CreatorQualification, nid1, n1.NftHash(), n1.Uri(), sender.Privs()[0], t.cid),
 	}
 	fact := NewSignFact(token, sender.Address, items)
 	sig, err := base.NewFactSignature(sender.Privs()[0], fact, nil)
@@ -537,7 +682,7 @@ func (t *testSignOperations) TestInSufficientBalanceWithFee() {
 		"",
 		"https://localhost:5000/nft",
 		sender.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 
@@ -559,7 +704,7 @@ func (t *testSignOperations) TestInSufficientBalanceWithFee() {
 
 	token := util.UUID().Bytes()
 	items := []SignItem{
-		t.newSignItem(CreatorQualification, nid, t.cid),
+		t.newSignItem(CreatorQualification, nid, n.NftHash(), n.Uri(), sender.Privs()[0], t.cid),
 	}
 	fact := NewSignFact(token, sender.Address, items)
 	sig, err := base.NewFactSignature(sender.Privs()[0], fact, nil)
@@ -594,7 +739,7 @@ func (t *testSignOperations) TestSameSenders() {
 		"",
 		"https://localhost:5000/nft/1",
 		sender.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 	n1 := nft.NewNFT(
@@ -604,7 +749,7 @@ func (t *testSignOperations) TestSameSenders() {
 		"",
 		"https://localhost:5000/nft/1",
 		sender.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 
@@ -627,7 +772,7 @@ func (t *testSignOperations) TestSameSenders() {
 
 	token0 := util.UUID().Bytes()
 	items0 := []SignItem{
-		t.newSignItem(CreatorQualification, nid0, t.cid),
+		t.newSignItem(CreatorQualification, nid0, n0.NftHash(), n0.Uri(), sender.Privs()[0], t.cid),
 	}
 	fact0 := NewSignFact(token0, sender.Address, items0)
 	sig0, err := base.NewFactSignature(sender.Privs()[0], fact0, nil)
@@ -640,7 +785,7 @@ func (t *testSignOperations) TestSameSenders() {
 
 	token1 := util.UUID().Bytes()
 	items1 := []SignItem{
-		t.newSignItem(CreatorQualification, nid1, t.cid),
+		t.newSignItem(CreatorQualification, nid1, n1.NftHash(), n1.Uri(), sender.Privs()[0], t.cid),
 	}
 	fact1 := NewSignFact(token1, sender.Address, items1)
 	sig1, err := base.NewFactSignature(sender.Privs()[0], fact1, nil)
@@ -678,7 +823,7 @@ func (t *testSignOperations) TestUnderThreshold() {
 		"",
 		"https://localhost:5000/nft/1",
 		sender,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 
@@ -702,7 +847,7 @@ func (t *testSignOperations) TestUnderThreshold() {
 
 	opr := t.processor(cp, pool)
 
-	items := []SignItem{t.newSignItem(CreatorQualification, nid, t.cid)}
+	items := []SignItem{t.newSignItem(CreatorQualification, nid, n.NftHash(), n.Uri(), spk, t.cid)}
 	signOp := t.newSign(sender, pks, items)
 
 	err := opr.Process(signOp)
@@ -724,7 +869,7 @@ func (t *testSignOperations) TestUnknownKey() {
 		"",
 		"https://localhost:5000/nft/1",
 		sender.Address,
-		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false)}),
+		nft.NewSigners(0, []nft.Signer{nft.NewSigner(sender.Address, 0, false, nil, nil)}),
 		nft.NewSigners(0, []nft.Signer{}),
 	)
 	nst := t.newStateNFT(n)
@@ -745,7 +890,7 @@ func (t *testSignOperations) TestUnknownKey() {
 
 	opr := t.processor(cp, pool)
 
-	items := []SignItem{t.newSignItem(CreatorQualification, nid, t.cid)}
+	items := []SignItem{t.newSignItem(CreatorQualification, nid, n.NftHash(), n.Uri(), sender.Priv, t.cid)}
 
 	signOp := t.newSign(sender.Address, []key.Privatekey{sender.Priv, key.NewBasePrivatekey()}, items)
 
@@ -756,6 +901,166 @@ func (t *testSignOperations) TestUnknownKey() {
 	t.Contains(err.Error(), "unknown key found")
 }
 
+func (t *testSignOperations) newSignerStates(sender *account, creators []nft.Signer) (nft.NFT, []state.State) {
+	var sts = []state.State{}
+
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+	sts = append(sts, pst)
+
+	var total uint
+	for i := range creators {
+		total += creators[i].Share()
+	}
+
+	nid := nft.NewNFTID(t.symbol, 1)
+	n := nft.NewNFT(
+		nid,
+		true,
+		sender.Address,
+		"",
+		"https://localhost:5000/nft",
+		sender.Address,
+		nft.NewSigners(total, creators),
+		nft.NewSigners(0, []nft.Signer{}),
+	)
+	sts = append(sts, t.newStateNFT(n))
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	return n, sts
+}
+
+func (t *testSignOperations) processedNFT(pool *storage.Statepool, nid nft.NFTID) nft.NFT {
+	var n nft.NFT
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyNFT(nid) {
+			n, _ = StateNFTValue(st.GetState())
+		}
+	}
+
+	return n
+}
+
+func (t *testSignOperations) TestUnsign() {
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+
+	n, sts := t.newSignerStates(sender, []nft.Signer{nft.NewSigner(sender.Address, 10, true, nil, nil)})
+	sts = append(sts, sst...)
+
+	items := []SignItem{NewSignItem(CreatorUnsignQualification, n.ID(), nil, nil, t.cid)}
+	signOp := t.newSign(sender.Address, sender.Privs(), items)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	t.NoError(opr.Process(signOp))
+
+	nf := t.processedNFT(pool, n.ID())
+	t.Equal(1, len(nf.Creators().Signers()))
+	t.False(nf.Creators().IsSignedByAddress(sender.Address))
+	t.False(nf.Creators().Signers()[0].Attested())
+	t.Equal(uint(10), nf.Creators().Signers()[0].Share())
+}
+
+func (t *testSignOperations) TestUnsignNotSigned() {
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+
+	n, sts := t.newSignerStates(sender, []nft.Signer{nft.NewSigner(sender.Address, 10, false, nil, nil)})
+	sts = append(sts, sst...)
+
+	items := []SignItem{NewSignItem(CreatorUnsignQualification, n.ID(), nil, nil, t.cid)}
+	signOp := t.newSign(sender.Address, sender.Privs(), items)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err := opr.Process(signOp)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "has not signed nft")
+}
+
+func (t *testSignOperations) TestUnsignNotSigner() {
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+
+	n, sts := t.newSignerStates(sender, []nft.Signer{nft.NewSigner(nft.NewTestAddress(), 10, true, nil, nil)})
+	sts = append(sts, sst...)
+
+	items := []SignItem{NewSignItem(CreatorUnsignQualification, n.ID(), nil, nil, t.cid)}
+	signOp := t.newSign(sender.Address, sender.Privs(), items)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err := opr.Process(signOp)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not signer of nft")
+}
+
+func (t *testSignOperations) TestSignReplacement() {
+	owner, ost := t.newAccount(true, nil)
+	old := nft.NewTestAddress()
+	nw, nst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+
+	n, sts := t.newSignerStates(owner, []nft.Signer{nft.NewSigner(old, 7, true, nil, nil)})
+	sts = append(sts, ost...)
+	sts = append(sts, nst...)
+
+	box := NewSignerReplacementBox(n.ID(), []SignerReplacement{NewSignerReplacement(CreatorQualification, old, nw.Address)})
+	value, _ := state.NewHintedValue(box)
+	bst, err := state.NewStateV0(StateKeySignerReplacement(n.ID()), value, base.NilHeight)
+	t.NoError(err)
+	sts = append(sts, bst)
+
+	items := []SignItem{t.newSignItem(CreatorQualification, n.ID(), n.NftHash(), n.Uri(), nw.Privs()[0], t.cid)}
+	signOp := t.newSign(nw.Address, nw.Privs(), items)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	t.NoError(opr.Process(signOp))
+
+	nf := t.processedNFT(pool, n.ID())
+	t.Equal(1, len(nf.Creators().Signers()))
+	t.True(nf.Creators().IsSignedByAddress(nw.Address))
+	t.True(nf.Creators().IndexByAddress(old) < 0)
+	t.Equal(uint(7), nf.Creators().Signers()[0].Share())
+
+	var ubox SignerReplacementBox
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySignerReplacement(n.ID()) {
+			ubox, _ = StateSignerReplacementValue(st.GetState())
+		}
+	}
+	t.Empty(ubox.Replacements())
+}
+
+func (t *testSignOperations) TestSignReplacementNotProposed() {
+	owner, ost := t.newAccount(true, nil)
+	old := nft.NewTestAddress()
+	nw, nst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+
+	n, sts := t.newSignerStates(owner, []nft.Signer{nft.NewSigner(old, 7, true, nil, nil)})
+	sts = append(sts, ost...)
+	sts = append(sts, nst...)
+
+	items := []SignItem{t.newSignItem(CreatorQualification, n.ID(), n.NftHash(), n.Uri(), nw.Privs()[0], t.cid)}
+	signOp := t.newSign(nw.Address, nw.Privs(), items)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err := opr.Process(signOp)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not signer of nft")
+}
+
 func TestSignOperations(t *testing.T) {
 	suite.Run(t, new(testSignOperations))
 }
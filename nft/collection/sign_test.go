@@ -24,7 +24,7 @@ func (t *testSign) TestNew() {
 
 	token := util.UUID().Bytes()
 	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
-	items := []SignItem{NewSignItem(CreatorQualification, nid, "MCC")}
+	items := []SignItem{newTestSignItem(CreatorQualification, nid, "MCC")}
 	fact := NewSignFact(token, sender, items)
 
 	var fs []base.FactSign
@@ -55,8 +55,8 @@ func (t *testSign) TestDeplicateNFTID() {
 	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
 
 	items := []SignItem{
-		NewSignItem(CreatorQualification, nid, "MCC"),
-		NewSignItem(CopyrighterQualification, nid, "MCC"),
+		newTestSignItem(CreatorQualification, nid, "MCC"),
+		newTestSignItem(CopyrighterQualification, nid, "MCC"),
 	}
 	fact := NewSignFact(token, sender, items)
 
@@ -98,17 +98,17 @@ func (t *testSign) TestOverMaxItems() {
 	token := util.UUID().Bytes()
 
 	items := []SignItem{
-		NewSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1), "MCC"),
-		NewSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 2), "MCC"),
-		NewSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 3), "MCC"),
-		NewSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 4), "MCC"),
-		NewSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 5), "MCC"),
-		NewSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 6), "MCC"),
-		NewSignItem(CopyrighterQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 7), "MCC"),
-		NewSignItem(CopyrighterQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 8), "MCC"),
-		NewSignItem(CopyrighterQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 9), "MCC"),
-		NewSignItem(CopyrighterQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 10), "MCC"),
-		NewSignItem(CopyrighterQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 11), "MCC"),
+		newTestSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1), "MCC"),
+		newTestSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 2), "MCC"),
+		newTestSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 3), "MCC"),
+		newTestSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 4), "MCC"),
+		newTestSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 5), "MCC"),
+		newTestSignItem(CreatorQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 6), "MCC"),
+		newTestSignItem(CopyrighterQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 7), "MCC"),
+		newTestSignItem(CopyrighterQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 8), "MCC"),
+		newTestSignItem(CopyrighterQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 9), "MCC"),
+		newTestSignItem(CopyrighterQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 10), "MCC"),
+		newTestSignItem(CopyrighterQualification, nft.NewNFTID(extensioncurrency.ContractID("ABC"), 11), "MCC"),
 	}
 	fact := NewSignFact(token, sender, items)
 
@@ -130,7 +130,7 @@ func (t *testSign) TestOverSizeMemo() {
 
 	token := util.UUID().Bytes()
 	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
-	items := []SignItem{NewSignItem(CreatorQualification, nid, "MCC")}
+	items := []SignItem{newTestSignItem(CreatorQualification, nid, "MCC")}
 	fact := NewSignFact(token, sender, items)
 
 	var fs []base.FactSign
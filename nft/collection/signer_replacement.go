@@ -0,0 +1,152 @@
+package collection
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	SignerReplacementType   = hint.Type("mitum-nft-signer-replacement")
+	SignerReplacementHint   = hint.NewHint(SignerReplacementType, "v0.0.1")
+	SignerReplacementHinter = SignerReplacement{BaseHinter: hint.NewBaseHinter(SignerReplacementHint)}
+)
+
+// SignerReplacement is the signer replacement proposed by nft owner. It takes
+// effect when the new signer signs nft.
+type SignerReplacement struct {
+	hint.BaseHinter
+	qualification Qualification
+	old           base.Address
+	new           base.Address
+}
+
+func NewSignerReplacement(q Qualification, old, nw base.Address) SignerReplacement {
+	return SignerReplacement{
+		BaseHinter:    hint.NewBaseHinter(SignerReplacementHint),
+		qualification: q,
+		old:           old,
+		new:           nw,
+	}
+}
+
+func (sr SignerReplacement) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		sr.qualification.Bytes(),
+		sr.old.Bytes(),
+		sr.new.Bytes(),
+	)
+}
+
+func (sr SignerReplacement) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, sr.BaseHinter, sr.qualification, sr.old, sr.new); err != nil {
+		return err
+	}
+
+	if sr.qualification.Unsign() {
+		return isvalid.InvalidError.Errorf("invalid qualification for signer replacement; %q", sr.qualification)
+	}
+
+	if sr.old.Equal(sr.new) {
+		return isvalid.InvalidError.Errorf("old signer is same with new signer; %q", sr.old)
+	}
+
+	return nil
+}
+
+func (sr SignerReplacement) Qualification() Qualification {
+	return sr.qualification
+}
+
+func (sr SignerReplacement) Old() base.Address {
+	return sr.old
+}
+
+func (sr SignerReplacement) New() base.Address {
+	return sr.new
+}
+
+type SignerReplacementJSONPacker struct {
+	jsonenc.HintedHead
+	QU Qualification `json:"qualification"`
+	OL base.Address  `json:"old"`
+	NW base.Address  `json:"new"`
+}
+
+func (sr SignerReplacement) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SignerReplacementJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sr.Hint()),
+		QU:         sr.qualification,
+		OL:         sr.old,
+		NW:         sr.new,
+	})
+}
+
+type SignerReplacementJSONUnpacker struct {
+	QU string              `json:"qualification"`
+	OL base.AddressDecoder `json:"old"`
+	NW base.AddressDecoder `json:"new"`
+}
+
+func (sr *SignerReplacement) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usr SignerReplacementJSONUnpacker
+	if err := enc.Unmarshal(b, &usr); err != nil {
+		return err
+	}
+
+	return sr.unpack(enc, usr.QU, usr.OL, usr.NW)
+}
+
+func (sr SignerReplacement) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sr.Hint()),
+		bson.M{
+			"qualification": sr.qualification,
+			"old":           sr.old,
+			"new":           sr.new,
+		}),
+	)
+}
+
+type SignerReplacementBSONUnpacker struct {
+	QU string              `bson:"qualification"`
+	OL base.AddressDecoder `bson:"old"`
+	NW base.AddressDecoder `bson:"new"`
+}
+
+func (sr *SignerReplacement) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usr SignerReplacementBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &usr); err != nil {
+		return err
+	}
+
+	return sr.unpack(enc, usr.QU, usr.OL, usr.NW)
+}
+
+func (sr *SignerReplacement) unpack(
+	enc encoder.Encoder,
+	q string,
+	bold base.AddressDecoder,
+	bnew base.AddressDecoder,
+) error {
+	sr.qualification = Qualification(q)
+
+	old, err := bold.Encode(enc)
+	if err != nil {
+		return err
+	}
+	sr.old = old
+
+	nw, err := bnew.Encode(enc)
+	if err != nil {
+		return err
+	}
+	sr.new = nw
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	SignerReplacementBoxType   = hint.Type("mitum-nft-signer-replacement-box")
+	SignerReplacementBoxHint   = hint.NewHint(SignerReplacementBoxType, "v0.0.1")
+	SignerReplacementBoxHinter = SignerReplacementBox{BaseHinter: hint.NewBaseHinter(SignerReplacementBoxHint)}
+)
+
+type SignerReplacementBox struct {
+	hint.BaseHinter
+	nft          nft.NFTID
+	replacements []SignerReplacement
+}
+
+func NewSignerReplacementBox(id nft.NFTID, replacements []SignerReplacement) SignerReplacementBox {
+	if replacements == nil {
+		return SignerReplacementBox{BaseHinter: hint.NewBaseHinter(SignerReplacementBoxHint), nft: id, replacements: []SignerReplacement{}}
+	}
+	return SignerReplacementBox{BaseHinter: hint.NewBaseHinter(SignerReplacementBoxHint), nft: id, replacements: replacements}
+}
+
+func (sbx SignerReplacementBox) Bytes() []byte {
+	bs := make([][]byte, len(sbx.replacements))
+	for i := range sbx.replacements {
+		bs[i] = sbx.replacements[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(sbx.nft.Bytes(), util.ConcatBytesSlice(bs...))
+}
+
+func (sbx SignerReplacementBox) Hint() hint.Hint {
+	return SignerReplacementBoxHint
+}
+
+func (sbx SignerReplacementBox) Hash() valuehash.Hash {
+	return sbx.GenerateHash()
+}
+
+func (sbx SignerReplacementBox) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(sbx.Bytes())
+}
+
+func (sbx SignerReplacementBox) IsValid([]byte) error {
+	if err := sbx.nft.IsValid(nil); err != nil {
+		return err
+	}
+
+	for i := range sbx.replacements {
+		if err := sbx.replacements[i].IsValid(nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sbx SignerReplacementBox) NFT() nft.NFTID {
+	return sbx.nft
+}
+
+func (sbx SignerReplacementBox) Replacements() []SignerReplacement {
+	return sbx.replacements
+}
+
+// Get finds the replacement proposed for the new signer.
+func (sbx SignerReplacementBox) Get(q Qualification, nw base.Address) (SignerReplacement, bool) {
+	for i := range sbx.replacements {
+		r := sbx.replacements[i]
+		if r.Qualification() == q && r.New().Equal(nw) {
+			return r, true
+		}
+	}
+	return SignerReplacement{}, false
+}
+
+// Set adds the replacement. The previous replacement of same old signer is
+// overwritten.
+func (sbx *SignerReplacementBox) Set(r SignerReplacement) error {
+	if err := r.IsValid(nil); err != nil {
+		return err
+	}
+
+	for i := range sbx.replacements {
+		if sbx.replacements[i].Qualification() == r.Qualification() && sbx.replacements[i].Old().Equal(r.Old()) {
+			sbx.replacements[i] = r
+			return nil
+		}
+	}
+
+	if len(sbx.replacements) >= nft.MaxSigners*2 {
+		return errors.Errorf("max signer replacements; %q", sbx.nft)
+	}
+
+	sbx.replacements = append(sbx.replacements, r)
+	return nil
+}
+
+func (sbx *SignerReplacementBox) Remove(r SignerReplacement) error {
+	for i := range sbx.replacements {
+		if sbx.replacements[i].Qualification() == r.Qualification() && sbx.replacements[i].Old().Equal(r.Old()) {
+			sbx.replacements = append(sbx.replacements[:i], sbx.replacements[i+1:]...)
+			return nil
+		}
+	}
+	return errors.Errorf("signer replacement not found; %q", r.Old())
+}
+
+type SignerReplacementBoxJSONPacker struct {
+	jsonenc.HintedHead
+	NF nft.NFTID           `json:"nft"`
+	RS []SignerReplacement `json:"replacements"`
+}
+
+func (sbx SignerReplacementBox) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SignerReplacementBoxJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sbx.Hint()),
+		NF:         sbx.nft,
+		RS:         sbx.replacements,
+	})
+}
+
+type SignerReplacementBoxJSONUnpacker struct {
+	NF json.RawMessage `json:"nft"`
+	RS json.RawMessage `json:"replacements"`
+}
+
+func (sbx *SignerReplacementBox) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubox SignerReplacementBoxJSONUnpacker
+	if err := enc.Unmarshal(b, &ubox); err != nil {
+		return err
+	}
+
+	return sbx.unpack(enc, ubox.NF, ubox.RS)
+}
+
+func (sbx SignerReplacementBox) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sbx.Hint()),
+		bson.M{
+			"nft":          sbx.nft,
+			"replacements": sbx.replacements,
+		}),
+	)
+}
+
+type SignerReplacementBoxBSONUnpacker struct {
+	NF bson.Raw `bson:"nft"`
+	RS bson.Raw `bson:"replacements"`
+}
+
+func (sbx *SignerReplacementBox) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubox SignerReplacementBoxBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &ubox); err != nil {
+		return err
+	}
+
+	return sbx.unpack(enc, ubox.NF, ubox.RS)
+}
+
+func (sbx *SignerReplacementBox) unpack(
+	enc encoder.Encoder,
+	bn []byte,
+	brs []byte,
+) error {
+	if hinter, err := enc.Decode(bn); err != nil {
+		return err
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return util.WrongTypeError.Errorf("not NFTID; %T", hinter)
+	} else {
+		sbx.nft = n
+	}
+
+	hrs, err := enc.DecodeSlice(brs)
+	if err != nil {
+		return err
+	}
+
+	replacements := make([]SignerReplacement, len(hrs))
+	for i := range hrs {
+		r, ok := hrs[i].(SignerReplacement)
+		if !ok {
+			return util.WrongTypeError.Errorf("not SignerReplacement; %T", hrs[i])
+		}
+		replacements[i] = r
+	}
+	sbx.replacements = replacements
+
+	return nil
+}
@@ -1,8 +1,11 @@
 package collection
 
 import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/pkg/errors"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
 )
@@ -12,17 +15,93 @@ func checkFactSignsByState(
 	fs []base.FactSign,
 	getState func(string) (state.State, bool, error),
 ) error {
-	st, err := existsState(currency.StateKeyAccount(address), "keys of account", getState)
+	keys, err := signingKeys(address, getState)
 	if err != nil {
 		return err
 	}
+
+	if err := checkThreshold(fs, keys); err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return nil
+}
+
+// signingKeys returns the keys signing for address. Contract account has no
+// keys to sign; the owner of active contract account signs for it.
+func signingKeys(
+	address base.Address,
+	getState func(string) (state.State, bool, error),
+) (currency.AccountKeys, error) {
+	switch st, found, err := getState(extensioncurrency.StateKeyContractAccount(address)); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case found:
+		ca, err := extensioncurrency.StateContractAccountValue(st)
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		} else if !ca.IsActive() {
+			return nil, operation.NewBaseReasonError("deactivated contract account; %q", address)
+		}
+
+		address = ca.Owner()
+	}
+
+	st, err := existsState(currency.StateKeyAccount(address), "keys of account", getState)
+	if err != nil {
+		return nil, err
+	}
+
 	keys, err := currency.StateKeysValue(st)
 	if err != nil {
-		return operation.NewBaseReasonErrorFromError(err)
+		return nil, operation.NewBaseReasonErrorFromError(err)
 	}
 
-	if err := checkThreshold(fs, keys); err != nil {
-		return operation.NewBaseReasonErrorFromError(err)
+	return keys, nil
+}
+
+// checkAttestationKey checks the attestation publickey is one of the keys of
+// account.
+func checkAttestationKey(
+	address base.Address,
+	pub key.Publickey,
+	getState func(string) (state.State, bool, error),
+) error {
+	keys, err := signingKeys(address, getState)
+	if err != nil {
+		return err
+	}
+
+	if _, found := keys.Key(pub); !found {
+		return errors.Errorf("attestation key is not the key of account; %q, %q", pub, address)
+	}
+
+	return nil
+}
+
+// checkFactSignsByAccounts checks fact signs of each account pass the threshold
+// of the account. Fact signs not belonging to the accounts are ignored.
+func checkFactSignsByAccounts(
+	addresses []base.Address,
+	fs []base.FactSign,
+	getState func(string) (state.State, bool, error),
+) error {
+	for i := range addresses {
+		keys, err := signingKeys(addresses[i], getState)
+		if err != nil {
+			return err
+		}
+
+		var afs []base.FactSign
+		for j := range fs {
+			if _, found := keys.Key(fs[j].Signer()); found {
+				afs = append(afs, fs[j])
+			}
+		}
+
+		if err := checkThreshold(afs, keys); err != nil {
+			return operation.NewBaseReasonError("%s; %q", err.Error(), addresses[i])
+		}
 	}
 
 	return nil
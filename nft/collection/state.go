@@ -11,6 +11,7 @@ import (
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 var (
@@ -21,7 +22,14 @@ var (
 	StateKeyAgentsSuffix            = ":agents"
 	StateKeyCollectionLastIDXSuffix = ":collectionidx"
 	StateKeyNFTsSuffix              = ":nfts"
+	StateKeyNFTsPageSuffix          = ":nftspage"
+	StateKeyHoldingsSuffix          = ":holdings"
+	StateKeyHoldingsIndexSuffix     = ":holdingsindex"
+	StateKeyNFTHashSuffix           = ":nfthash"
+	StateKeyNFTHashIndexSuffix      = ":nfthashindex"
 	StateKeyNFTSuffix               = ":nft"
+	StateKeyNFTChildrenSuffix       = ":nftchildren"
+	StateKeySignerReplacementSuffix = ":signerreplacement"
 )
 
 func StateKeyAgents(addr base.Address, symbol extensioncurrency.ContractID) string {
@@ -82,6 +90,9 @@ func SetStateCollectionValue(st state.State, design nft.Design) (state.State, er
 	}
 }
 
+// StateKeyNFTs is the key of the nft box which indexed all the nfts of
+// collection before the index was paged. It is only read to build the pages of
+// the collection.
 func StateKeyNFTs(id extensioncurrency.ContractID) string {
 	return fmt.Sprintf("%s%s", id, StateKeyNFTsSuffix)
 }
@@ -111,6 +122,331 @@ func SetStateNFTsValue(st state.State, box NFTBox) (state.State, error) {
 	}
 }
 
+// NFTBoxPageSize is the number of nft idxes covered by one page of collection
+// nft index.
+const NFTBoxPageSize uint64 = 100
+
+func NFTBoxPage(idx uint64) uint64 {
+	return idx / NFTBoxPageSize
+}
+
+func StateKeyNFTsPage(id extensioncurrency.ContractID, page uint64) string {
+	return fmt.Sprintf("%s-%d%s", id, page, StateKeyNFTsPageSuffix)
+}
+
+func IsStateNFTsPageKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyNFTsPageSuffix)
+}
+
+// loadNFTBoxPage loads the page of collection nft index which id belongs to.
+// If the page does not exist yet, it is built from the nfts of the legacy nft
+// box of the collection in the same page.
+func loadNFTBoxPage(
+	id nft.NFTID,
+	getState func(key string) (state.State, bool, error),
+) (NFTBox, state.State, error) {
+	st, found, err := getState(StateKeyNFTsPage(id.Collection(), NFTBoxPage(id.Idx())))
+	switch {
+	case err != nil:
+		return NFTBox{}, nil, err
+	case found:
+		box, err := StateNFTsValue(st)
+		if err != nil {
+			return NFTBox{}, nil, err
+		}
+
+		return box, st, nil
+	}
+
+	switch lst, found, err := getState(StateKeyNFTs(id.Collection())); {
+	case err != nil:
+		return NFTBox{}, nil, err
+	case !found:
+		return NewNFTBox(nil), st, nil
+	default:
+		legacy, err := StateNFTsValue(lst)
+		if err != nil {
+			return NFTBox{}, nil, err
+		}
+
+		page := NFTBoxPage(id.Idx())
+
+		nfts := []nft.NFTID{}
+		for _, n := range legacy.NFTs() {
+			if NFTBoxPage(n.Idx()) == page {
+				nfts = append(nfts, n)
+			}
+		}
+
+		return NewNFTBox(nfts), st, nil
+	}
+}
+
+// StateKeyHoldings is the key of the nft box of the nfts which addr owns in
+// collection. Holdings are kept from minting, transfer and burning.
+func StateKeyHoldings(addr base.Address, symbol extensioncurrency.ContractID) string {
+	return fmt.Sprintf("%s-%s%s", addr, symbol, StateKeyHoldingsSuffix)
+}
+
+func IsStateHoldingsKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyHoldingsSuffix)
+}
+
+// StateKeyHoldingsIndex is the key of the number of the nfts in the legacy nft
+// box of collection, which were added to the holdings of their owners.
+func StateKeyHoldingsIndex(symbol extensioncurrency.ContractID) string {
+	return fmt.Sprintf("%s%s", symbol, StateKeyHoldingsIndexSuffix)
+}
+
+func IsStateHoldingsIndexKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyHoldingsIndexSuffix)
+}
+
+func SetStateHoldingsIndexValue(st state.State, n uint64) (state.State, error) {
+	if vn, err := state.NewNumberValue(n); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(vn)
+	}
+}
+
+// loadLegacyNFTs loads the active nfts of the legacy nft box of collection.
+// The legacy nft box was kept under the old idx cap, nft.MaxNFTIdx, so loading
+// it reads nft.MaxNFTIdx nfts at most.
+func loadLegacyNFTs(
+	symbol extensioncurrency.ContractID,
+	getState func(key string) (state.State, bool, error),
+) (NFTBox, []nft.NFT, error) {
+	var legacy NFTBox
+	switch lst, found, err := getState(StateKeyNFTs(symbol)); {
+	case err != nil:
+		return NFTBox{}, nil, err
+	case !found:
+		return NewNFTBox(nil), nil, nil
+	default:
+		if legacy, err = StateNFTsValue(lst); err != nil {
+			return NFTBox{}, nil, err
+		}
+	}
+
+	if l := uint64(len(legacy.NFTs())); l > nft.MaxNFTIdx {
+		return NFTBox{}, nil, errors.Errorf("legacy nft box over max; %d > %d", l, nft.MaxNFTIdx)
+	}
+
+	var nfts []nft.NFT
+	for _, id := range legacy.NFTs() {
+		switch nst, found, err := getState(StateKeyNFT(id)); {
+		case err != nil:
+			return NFTBox{}, nil, err
+		case !found:
+			continue
+		default:
+			n, err := StateNFTValue(nst)
+			if err != nil {
+				return NFTBox{}, nil, err
+			}
+
+			if n.Active() {
+				nfts = append(nfts, n)
+			}
+		}
+	}
+
+	return legacy, nfts, nil
+}
+
+// holdingsPool keeps the holdings loaded in one operation. The active nfts of
+// the legacy nft box of collection are added to the holdings of their owners
+// once, at the first load in collection, and the holdings index marks it; the
+// nfts minted after holdings were kept are always in the holdings of their
+// owners.
+type holdingsPool struct {
+	boxes   map[string]*NFTBox
+	states  map[string]state.State
+	checked map[extensioncurrency.ContractID]struct{}
+	indexes map[extensioncurrency.ContractID]state.State
+}
+
+func newHoldingsPool() *holdingsPool {
+	return &holdingsPool{
+		boxes:   map[string]*NFTBox{},
+		states:  map[string]state.State{},
+		checked: map[extensioncurrency.ContractID]struct{}{},
+		indexes: map[extensioncurrency.ContractID]state.State{},
+	}
+}
+
+// load loads the holdings of addr in collection.
+func (hp *holdingsPool) load(
+	addr base.Address,
+	symbol extensioncurrency.ContractID,
+	getState func(key string) (state.State, bool, error),
+) (*NFTBox, error) {
+	if err := hp.index(symbol, getState); err != nil {
+		return nil, err
+	}
+
+	box, _, err := hp.loadBox(StateKeyHoldings(addr, symbol), getState)
+
+	return box, err
+}
+
+func (hp *holdingsPool) loadBox(
+	key string,
+	getState func(key string) (state.State, bool, error),
+) (*NFTBox, bool, error) {
+	if box, found := hp.boxes[key]; found {
+		return box, true, nil
+	}
+
+	st, found, err := getState(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	box := NewNFTBox(nil)
+	if found {
+		if box, err = StateNFTsValue(st); err != nil {
+			return nil, false, err
+		}
+	}
+
+	hp.boxes[key] = &box
+	hp.states[key] = st
+
+	return &box, found, nil
+}
+
+// index adds the active nfts of the legacy nft box of collection to the
+// holdings of their owners, unless the holdings index exists. The holdings
+// kept before already have their legacy nfts.
+func (hp *holdingsPool) index(
+	symbol extensioncurrency.ContractID,
+	getState func(key string) (state.State, bool, error),
+) error {
+	if _, found := hp.checked[symbol]; found {
+		return nil
+	}
+	hp.checked[symbol] = struct{}{}
+
+	ist, found, err := getState(StateKeyHoldingsIndex(symbol))
+	switch {
+	case err != nil:
+		return err
+	case found:
+		return nil
+	}
+
+	legacy, nfts, err := loadLegacyNFTs(symbol, getState)
+	switch {
+	case err != nil:
+		return err
+	case legacy.IsEmpty():
+		return nil
+	}
+
+	kept := map[string]bool{}
+	for i := range nfts {
+		key := StateKeyHoldings(nfts[i].Owner(), symbol)
+		box, found, err := hp.loadBox(key, getState)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := kept[key]; !ok {
+			kept[key] = found
+		}
+
+		if kept[key] || box.Exists(nfts[i].ID()) {
+			continue
+		}
+
+		if err := box.Append(nfts[i].ID()); err != nil {
+			return err
+		}
+	}
+
+	st, err := SetStateHoldingsIndexValue(ist, uint64(len(legacy.NFTs())))
+	if err != nil {
+		return err
+	}
+	hp.indexes[symbol] = st
+
+	return nil
+}
+
+// States returns the states of the holdings and the holdings indexes.
+func (hp *holdingsPool) States() ([]state.State, error) {
+	var sts []state.State
+	for k, box := range hp.boxes {
+		st, err := SetStateNFTsValue(hp.states[k], *box)
+		if err != nil {
+			return nil, err
+		}
+		sts = append(sts, st)
+	}
+
+	for _, st := range hp.indexes {
+		sts = append(sts, st)
+	}
+
+	return sts, nil
+}
+
+// StateKeyNFTHash is the key of the nft box of the active nfts minted with nft
+// hash h in any collection. StateKeyCollectionNFTHash is the key of the ones in
+// collection. h is normalized, so the same digest in the other forms shares
+// the key.
+func StateKeyNFTHash(h nft.NFTHash) string {
+	return fmt.Sprintf("%s%s", valuehash.NewSHA256(h.Normalize().Bytes()), StateKeyNFTHashSuffix)
+}
+
+func StateKeyCollectionNFTHash(symbol extensioncurrency.ContractID, h nft.NFTHash) string {
+	return fmt.Sprintf("%s-%s%s", symbol, valuehash.NewSHA256(h.Normalize().Bytes()), StateKeyNFTHashSuffix)
+}
+
+func IsStateNFTHashKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyNFTHashSuffix)
+}
+
+func loadNFTHashBox(
+	key string,
+	getState func(key string) (state.State, bool, error),
+) (NFTBox, state.State, error) {
+	switch st, found, err := getState(key); {
+	case err != nil:
+		return NFTBox{}, nil, err
+	case !found:
+		return NewNFTBox(nil), st, nil
+	default:
+		box, err := StateNFTsValue(st)
+		if err != nil {
+			return NFTBox{}, nil, err
+		}
+
+		return box, st, nil
+	}
+}
+
+// StateKeyNFTHashIndex is the key of the number of the nfts in the legacy nft
+// box of collection, which were indexed by nft hash. It is written once, and
+// its existence marks the legacy nfts of collection indexed.
+func StateKeyNFTHashIndex(symbol extensioncurrency.ContractID) string {
+	return fmt.Sprintf("%s%s", symbol, StateKeyNFTHashIndexSuffix)
+}
+
+func IsStateNFTHashIndexKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyNFTHashIndexSuffix)
+}
+
+func SetStateNFTHashIndexValue(st state.State, n uint64) (state.State, error) {
+	if vn, err := state.NewNumberValue(n); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(vn)
+	}
+}
+
 func StateKeyNFT(id nft.NFTID) string {
 	return fmt.Sprintf("%s%s", id, StateKeyNFTSuffix)
 }
@@ -140,6 +476,150 @@ func SetStateNFTValue(st state.State, n nft.NFT) (state.State, error) {
 	}
 }
 
+// StateKeyNFTChildren is the key of the nft box of the nfts which the nft of id
+// owns directly.
+func StateKeyNFTChildren(id nft.NFTID) string {
+	return fmt.Sprintf("%s%s", id, StateKeyNFTChildrenSuffix)
+}
+
+func IsStateNFTChildrenKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyNFTChildrenSuffix)
+}
+
+func loadNFTChildren(
+	id nft.NFTID,
+	getState func(key string) (state.State, bool, error),
+) (NFTBox, state.State, error) {
+	switch st, found, err := getState(StateKeyNFTChildren(id)); {
+	case err != nil:
+		return NFTBox{}, nil, err
+	case !found:
+		return NewNFTBox(nil), st, nil
+	default:
+		box, err := StateNFTsValue(st)
+		if err != nil {
+			return NFTBox{}, nil, err
+		}
+
+		return box, st, nil
+	}
+}
+
+// nftSubtreeHeight returns the number of the levels of the nfts nested under
+// the nft of id.
+func nftSubtreeHeight(
+	id nft.NFTID,
+	getState func(key string) (state.State, bool, error),
+) (int, error) {
+	return walkNFTChildren(id, 0, getState)
+}
+
+func walkNFTChildren(
+	id nft.NFTID,
+	depth int,
+	getState func(key string) (state.State, bool, error),
+) (int, error) {
+	if depth > MaxNFTDepth {
+		return 0, errors.Errorf("nft nested over max depth, %d; %q", MaxNFTDepth, id)
+	}
+
+	box, _, err := loadNFTChildren(id, getState)
+	if err != nil {
+		return 0, err
+	}
+
+	var height int
+	for _, c := range box.NFTs() {
+		h, err := walkNFTChildren(c, depth+1, getState)
+		if err != nil {
+			return 0, err
+		}
+
+		if h+1 > height {
+			height = h + 1
+		}
+	}
+
+	return height, nil
+}
+
+// MaxNFTDepth is the max number of parent nfts above nft.
+var MaxNFTDepth = 10
+
+// walkNFTOwner follows the parent nfts from owner up to the account owning
+// them. The ids of the parent nfts are returned from the nearest.
+func walkNFTOwner(
+	owner base.Address,
+	getState func(key string) (state.State, bool, error),
+) (base.Address, []nft.NFTID, error) {
+	var parents []nft.NFTID
+	for {
+		na, ok := owner.(nft.NFTAddress)
+		if !ok {
+			return owner, parents, nil
+		}
+
+		if len(parents) == MaxNFTDepth {
+			return nil, nil, errors.Errorf("nft nested over max depth, %d; %q", MaxNFTDepth, owner)
+		}
+
+		id, err := na.NFTID()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		st, err := existsState(StateKeyNFT(id), "parent nft", getState)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		parent, err := StateNFTValue(st)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		parents = append(parents, id)
+		owner = parent.Owner()
+	}
+}
+
+// nftAccountOwner returns the account which owns n directly or by the parent
+// nfts of n.
+func nftAccountOwner(n nft.NFT, getState func(key string) (state.State, bool, error)) (base.Address, error) {
+	owner, _, err := walkNFTOwner(n.Owner(), getState)
+
+	return owner, err
+}
+
+func StateKeySignerReplacement(id nft.NFTID) string {
+	return fmt.Sprintf("%s%s", id, StateKeySignerReplacementSuffix)
+}
+
+func IsStateSignerReplacementKey(key string) bool {
+	return strings.HasSuffix(key, StateKeySignerReplacementSuffix)
+}
+
+func StateSignerReplacementValue(st state.State) (SignerReplacementBox, error) {
+	value := st.Value()
+	if value == nil {
+		return SignerReplacementBox{}, util.NotFoundError.Errorf("signer replacement box not found in State")
+	}
+
+	if box, ok := value.Interface().(SignerReplacementBox); !ok {
+		return SignerReplacementBox{}, errors.Errorf("invalid signer replacement box value found; %T", value.Interface())
+	} else {
+		return box, nil
+	}
+}
+
+func SetStateSignerReplacementValue(st state.State, box SignerReplacementBox) (state.State, error) {
+	if vbox, err := state.NewHintedValue(box); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(vbox)
+	}
+}
+
 func StateKeyCollectionLastIDX(id extensioncurrency.ContractID) string {
 	return fmt.Sprintf("%s%s", id, StateKeyCollectionLastIDXSuffix)
 }
@@ -16,6 +16,8 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
+var testNetworkID = base.NetworkID([]byte("mitum-nft-test"))
+
 func MustAddress(s string) currency.Address {
 	a := currency.NewAddress(s)
 	if err := a.IsValid(nil); err != nil {
@@ -169,7 +171,7 @@ func (t *baseTestOperationProcessor) newContractAccount(exists, active bool, own
 }
 
 func (t *baseTestOperationProcessor) newCollectionDesign(active bool, parent, creator base.Address, whites []base.Address, symbol extensioncurrency.ContractID, actives, deactives []nft.NFTID) (nft.Design, []state.State) {
-	policy := NewCollectionPolicy("Collection", 0, "", whites)
+	policy := NewCollectionPolicy("Collection", 0, "", whites, "", nil, false, 0, "")
 	design := nft.NewDesign(parent, creator, symbol, active, policy)
 	t.NoError(design.IsValid(nil))
 
@@ -178,16 +180,26 @@ func (t *baseTestOperationProcessor) newCollectionDesign(active bool, parent, cr
 	collectionState, err := state.NewStateV0(collectionKey, collectionValue, base.NilHeight)
 	t.NoError(err)
 
-	nftsKey := StateKeyNFTs(symbol)
-	nftsBox := NewNFTBox(actives)
-	nftsValue, _ := state.NewHintedValue(nftsBox)
-	nftsState, err := state.NewStateV0(nftsKey, nftsValue, base.NilHeight)
-
 	idxKey := StateKeyCollectionLastIDX(symbol)
 	idxValue, _ := state.NewNumberValue(uint64(len(actives)) + uint64(len(deactives)))
 	idxState, err := state.NewStateV0(idxKey, idxValue, base.NilHeight)
+	t.NoError(err)
 
-	sts := []state.State{collectionState, nftsState, idxState}
+	sts := []state.State{collectionState, idxState}
+
+	pages := map[uint64][]nft.NFTID{}
+	for i := range actives {
+		page := NFTBoxPage(actives[i].Idx())
+		pages[page] = append(pages[page], actives[i])
+	}
+
+	for page, nfts := range pages {
+		pageValue, _ := state.NewHintedValue(NewNFTBox(nfts))
+		pageState, err := state.NewStateV0(StateKeyNFTsPage(symbol, page), pageValue, base.NilHeight)
+		t.NoError(err)
+
+		sts = append(sts, pageState)
+	}
 
 	return design, sts
 }
@@ -201,6 +213,22 @@ func (t *baseTestOperationProcessor) newStateNFT(n nft.NFT) state.State {
 	return st
 }
 
+func (t *baseTestOperationProcessor) newStateHoldings(a base.Address, symbol extensioncurrency.ContractID, nfts []nft.NFTID) state.State {
+	value, _ := state.NewHintedValue(NewNFTBox(nfts))
+	st, err := state.NewStateV0(StateKeyHoldings(a, symbol), value, base.NilHeight)
+	t.NoError(err)
+
+	return st
+}
+
+func (t *baseTestOperationProcessor) newStateNFTChildren(id nft.NFTID, nfts []nft.NFTID) state.State {
+	value, _ := state.NewHintedValue(NewNFTBox(nfts))
+	st, err := state.NewStateV0(StateKeyNFTChildren(id), value, base.NilHeight)
+	t.NoError(err)
+
+	return st
+}
+
 func (t *baseTestOperationProcessor) newStateAmount(a base.Address, amount currency.Amount) state.State {
 	key := currency.StateKeyBalance(a, amount.Currency())
 	value, _ := state.NewHintedValue(amount)
@@ -76,7 +76,7 @@ func (t *testTransferItem) TestZeroIDX() {
 	t.Contains(err.Error(), "nid idx must be over zero")
 }
 
-func (t *testTransferItem) TestOverMaxIDX() {
+func (t *testTransferItem) TestOverDefaultMaxIDX() {
 	sender := MustAddress(util.UUID().String())
 	receiver := MustAddress(util.UUID().String())
 
@@ -98,8 +98,7 @@ func (t *testTransferItem) TestOverMaxIDX() {
 	transfer, err := NewTransfer(fact, fs, "")
 	t.NoError(err)
 
-	err = transfer.IsValid(nil)
-	t.Contains(err.Error(), "nid idx over max")
+	t.NoError(transfer.IsValid(nil))
 }
 
 func TestTransferItem(t *testing.T) {
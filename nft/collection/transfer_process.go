@@ -33,12 +33,17 @@ func (Transfer) Process(
 }
 
 type TransferItemProcessor struct {
-	cp     *extensioncurrency.CurrencyPool
-	h      valuehash.Hash
-	nft    nft.NFT
-	nst    state.State
-	sender base.Address
-	item   TransferItem
+	cp       *extensioncurrency.CurrencyPool
+	h        valuehash.Hash
+	nft      nft.NFT
+	nst      state.State
+	owner    base.Address
+	from     *NFTBox
+	to       *NFTBox
+	fromNFTs *NFTBox
+	toNFTs   *NFTBox
+	sender   base.Address
+	item     TransferItem
 }
 
 func (ipp *TransferItemProcessor) PreProcess(
@@ -49,16 +54,19 @@ func (ipp *TransferItemProcessor) PreProcess(
 		return err
 	}
 
+	nid := ipp.item.NFT()
+
 	// check receiver
 	receiver := ipp.item.Receiver()
-	if err := checkExistsState(currency.StateKeyAccount(receiver), getState); err != nil {
-		return err
-	}
-	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(receiver), getState); err != nil {
-		return errors.Errorf("contract account cannot receive nfts; %q", receiver)
+	if na, ok := receiver.(nft.NFTAddress); ok {
+		if err := checkNFTReceiver(nid, na, getState); err != nil {
+			return err
+		}
+	} else {
+		if err := checkExistsState(currency.StateKeyAccount(receiver), getState); err != nil {
+			return err
+		}
 	}
-
-	nid := ipp.item.NFT()
 	if st, err := existsState(StateKeyCollection(nid.Collection()), "design", getState); err != nil {
 		return errors.Errorf("%v; %q", err.Error(), nid.Collection())
 	} else if design, err := StateCollectionValue(st); err != nil {
@@ -89,6 +97,12 @@ func (ipp *TransferItemProcessor) PreProcess(
 		approved = nv.Approved()
 		owner = nv.Owner()
 
+		// NOTE approval of nft owned by parent nft is ignored; the account
+		// owning the parent controls it.
+		if _, ok := owner.(nft.NFTAddress); ok {
+			approved = nil
+		}
+
 		n := nft.NewNFT(nid, nv.Active(), receiver, nv.NftHash(), nv.Uri(), receiver, nv.Creators(), nv.Copyrighters())
 		if err := n.IsValid(nil); err != nil {
 			return err
@@ -96,10 +110,18 @@ func (ipp *TransferItemProcessor) PreProcess(
 
 		ipp.nft = n
 		ipp.nst = st
+		ipp.owner = owner
+	}
+
+	// check authorization; nft owned by parent nft follows the account owning
+	// the parent
+	if a, _, err := walkNFTOwner(owner, getState); err != nil {
+		return err
+	} else {
+		owner = a
 	}
 
-	// check authorization
-	if !(owner.Equal(ipp.sender) || approved.Equal(ipp.sender)) {
+	if !(owner.Equal(ipp.sender) || (approved != nil && approved.Equal(ipp.sender))) {
 		// check agent
 		if st, err := existsState(StateKeyAgents(owner, ipp.nft.ID().Collection()), "agents", getState); err != nil {
 			return errors.Errorf("unauthorized sender; %q", ipp.sender)
@@ -113,6 +135,50 @@ func (ipp *TransferItemProcessor) PreProcess(
 	return nil
 }
 
+// checkNFTReceiver checks the parent nft, na can own the nft of nid. The nft
+// cannot be nested in itself or in its children, and the nfts nested under it
+// must not be over MaxNFTDepth.
+func checkNFTReceiver(
+	nid nft.NFTID,
+	na nft.NFTAddress,
+	getState func(key string) (state.State, bool, error),
+) error {
+	pid, err := na.NFTID()
+	if err != nil {
+		return err
+	}
+
+	if st, err := existsState(StateKeyNFT(pid), "parent nft", getState); err != nil {
+		return err
+	} else if pv, err := StateNFTValue(st); err != nil {
+		return err
+	} else if !pv.Active() {
+		return errors.Errorf("burned parent nft; %q", pid)
+	}
+
+	_, parents, err := walkNFTOwner(na, getState)
+	if err != nil {
+		return err
+	}
+
+	height, err := nftSubtreeHeight(nid, getState)
+	if err != nil {
+		return err
+	}
+
+	if len(parents)+height >= MaxNFTDepth {
+		return errors.Errorf("nft nested over max depth, %d; %q", MaxNFTDepth, nid)
+	}
+
+	for i := range parents {
+		if parents[i].Equal(nid) {
+			return errors.Errorf("nft cannot be nested in itself; %q", nid)
+		}
+	}
+
+	return nil
+}
+
 func (ipp *TransferItemProcessor) Process(
 	_ func(key string) (state.State, bool, error),
 	_ func(valuehash.Hash, ...state.State) error,
@@ -120,6 +186,26 @@ func (ipp *TransferItemProcessor) Process(
 
 	var states []state.State
 
+	if err := ipp.from.Remove(ipp.nft.ID()); err != nil {
+		return nil, err
+	}
+
+	if err := ipp.to.Append(ipp.nft.ID()); err != nil {
+		return nil, err
+	}
+
+	if ipp.fromNFTs != nil {
+		if err := ipp.fromNFTs.Remove(ipp.nft.ID()); err != nil {
+			return nil, err
+		}
+	}
+
+	if ipp.toNFTs != nil {
+		if err := ipp.toNFTs.Append(ipp.nft.ID()); err != nil {
+			return nil, err
+		}
+	}
+
 	if st, err := SetStateNFTValue(ipp.nst, ipp.nft); err != nil {
 		return nil, err
 	} else {
@@ -134,6 +220,11 @@ func (ipp *TransferItemProcessor) Close() error {
 	ipp.h = nil
 	ipp.nft = nft.NFT{}
 	ipp.nst = nil
+	ipp.owner = nil
+	ipp.from = nil
+	ipp.to = nil
+	ipp.fromNFTs = nil
+	ipp.toNFTs = nil
 	ipp.sender = nil
 	ipp.item = TransferItem{}
 	TransferItemProcessorPool.Put(ipp)
@@ -145,6 +236,9 @@ type TransferProcessor struct {
 	cp *extensioncurrency.CurrencyPool
 	Transfer
 	ipps         []*TransferItemProcessor
+	holdings     *holdingsPool
+	children     map[string]*NFTBox
+	cstates      map[string]state.State
 	amountStates map[currency.CurrencyID]currency.AmountState
 	required     map[currency.CurrencyID][2]currency.Big
 }
@@ -161,6 +255,9 @@ func NewTransferProcessor(cp *extensioncurrency.CurrencyPool) currency.GetNewPro
 		opp.cp = cp
 		opp.Transfer = i
 		opp.ipps = nil
+		opp.holdings = nil
+		opp.children = nil
+		opp.cstates = nil
 		opp.amountStates = nil
 		opp.required = nil
 
@@ -185,22 +282,44 @@ func (opp *TransferProcessor) PreProcess(
 		return nil, operation.NewBaseReasonError(err.Error())
 	}
 
-	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getState); err != nil {
-		return nil, operation.NewBaseReasonError("contract account cannot transfer nfts; %q", fact.Sender())
-	}
-
 	if err := checkFactSignsByState(fact.Sender(), opp.Signs(), getState); err != nil {
 		return nil, operation.NewBaseReasonError("invalid signing; %w", err)
 	}
 
+	opp.holdings = newHoldingsPool()
+	opp.children = map[string]*NFTBox{}
+	opp.cstates = map[string]state.State{}
+
+	// NOTE nft can not be nested in the nft moved in the same transfer; the
+	// nesting is checked over the state before transfer.
+	moved := map[string]struct{}{}
+	for i := range fact.items {
+		moved[fact.items[i].NFT().String()] = struct{}{}
+	}
+
 	ipps := make([]*TransferItemProcessor, len(fact.items))
 	for i := range fact.items {
+		if na, ok := fact.items[i].Receiver().(nft.NFTAddress); ok {
+			pid, err := na.NFTID()
+			if err != nil {
+				return nil, operation.NewBaseReasonError(err.Error())
+			}
+
+			if _, found := moved[pid.String()]; found {
+				return nil, operation.NewBaseReasonError("parent nft moved in same transfer; %q", pid)
+			}
+		}
 
 		c := TransferItemProcessorPool.Get().(*TransferItemProcessor)
 		c.cp = opp.cp
 		c.h = opp.Hash()
 		c.nft = nft.NFT{}
 		c.nst = nil
+		c.owner = nil
+		c.from = nil
+		c.to = nil
+		c.fromNFTs = nil
+		c.toNFTs = nil
 		c.sender = fact.Sender()
 		c.item = fact.items[i]
 
@@ -208,6 +327,29 @@ func (opp *TransferProcessor) PreProcess(
 			return nil, operation.NewBaseReasonError(err.Error())
 		}
 
+		collection := c.nft.ID().Collection()
+		from, err := opp.holdings.load(c.owner, collection, getState)
+		if err != nil {
+			return nil, operation.NewBaseReasonError(err.Error())
+		}
+		to, err := opp.holdings.load(c.nft.Owner(), collection, getState)
+		if err != nil {
+			return nil, operation.NewBaseReasonError(err.Error())
+		}
+		c.from = from
+		c.to = to
+
+		fromNFTs, err := opp.loadChildren(c.owner, getState)
+		if err != nil {
+			return nil, operation.NewBaseReasonError(err.Error())
+		}
+		toNFTs, err := opp.loadChildren(c.nft.Owner(), getState)
+		if err != nil {
+			return nil, operation.NewBaseReasonError(err.Error())
+		}
+		c.fromNFTs = fromNFTs
+		c.toNFTs = toNFTs
+
 		ipps[i] = c
 	}
 
@@ -248,6 +390,20 @@ func (opp *TransferProcessor) Process(
 		}
 	}
 
+	if sts, err := opp.holdings.States(); err != nil {
+		return operation.NewBaseReasonError(err.Error())
+	} else {
+		states = append(states, sts...)
+	}
+
+	for k, box := range opp.children {
+		if st, err := SetStateNFTsValue(opp.cstates[k], *box); err != nil {
+			return operation.NewBaseReasonError(err.Error())
+		} else {
+			states = append(states, st)
+		}
+	}
+
 	for k := range opp.required {
 		rq := opp.required[k]
 		states = append(states, opp.amountStates[k].Sub(rq[0]).AddFee(rq[1]))
@@ -256,6 +412,37 @@ func (opp *TransferProcessor) Process(
 	return setState(fact.Hash(), states...)
 }
 
+// loadChildren loads the nfts owned by a, if a is nft address. nil is returned
+// for account.
+func (opp *TransferProcessor) loadChildren(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) (*NFTBox, error) {
+	na, ok := a.(nft.NFTAddress)
+	if !ok {
+		return nil, nil
+	}
+
+	id, err := na.NFTID()
+	if err != nil {
+		return nil, err
+	}
+
+	key := StateKeyNFTChildren(id)
+	if box, found := opp.children[key]; found {
+		return box, nil
+	}
+
+	box, st, err := loadNFTChildren(id, getState)
+	if err != nil {
+		return nil, err
+	}
+	opp.children[key] = &box
+	opp.cstates[key] = st
+
+	return &box, nil
+}
+
 func (opp *TransferProcessor) Close() error {
 	for i := range opp.ipps {
 		_ = opp.ipps[i].Close()
@@ -264,6 +451,9 @@ func (opp *TransferProcessor) Close() error {
 	opp.cp = nil
 	opp.Transfer = Transfer{}
 	opp.ipps = nil
+	opp.holdings = nil
+	opp.children = nil
+	opp.cstates = nil
 	opp.amountStates = nil
 	opp.required = nil
 
@@ -232,6 +232,7 @@ func (t *testTransferOperations) TestAgentTransfer() {
 
 	_, dst := t.newCollectionDesign(true, parent, owner.Address, []base.Address{owner.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
 	sts = append(sts, dst...)
+	sts = append(sts, t.newStateHoldings(owner.Address, t.symbol, []nft.NFTID{nid}))
 
 	items := []TransferItem{t.newTransferItem(receiver.Address, nid, t.cid)}
 	transfer := t.newTransfer(agent.Address, agent.Privs(), items)
@@ -291,6 +292,7 @@ func (t *testTransferOperations) TestApprovedTransfer() {
 
 	_, dst := t.newCollectionDesign(true, parent, owner.Address, []base.Address{owner.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
 	sts = append(sts, dst...)
+	sts = append(sts, t.newStateHoldings(owner.Address, t.symbol, []nft.NFTID{nid}))
 
 	items := []TransferItem{t.newTransferItem(receiver.Address, nid, t.cid)}
 	transfer := t.newTransfer(approved.Address, approved.Privs(), items)
@@ -395,6 +397,7 @@ func (t *testTransferOperations) TestMultipleItemsWithFee() {
 
 	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid0, nid1}, []nft.NFTID{})
 	sts = append(sts, dst...)
+	sts = append(sts, t.newStateHoldings(sender.Address, t.symbol, []nft.NFTID{nid0, nid1}))
 
 	pool, _ := t.statepool(sts)
 
@@ -449,6 +452,435 @@ func (t *testTransferOperations) TestMultipleItemsWithFee() {
 	t.True(nf1.Approved().Equal(receiver1.Address))
 }
 
+func (t *testTransferOperations) TestHoldings() {
+	sts := []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(33), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+	receiver, rst := t.newAccount(true, nil)
+
+	sts = append(sts, sst...)
+	sts = append(sts, rst...)
+	sts = append(sts, pst)
+
+	nid0 := nft.NewNFTID(t.symbol, 1)
+	nid1 := nft.NewNFTID(t.symbol, 2)
+	n0 := nft.NewNFT(nid0, true, sender.Address, "", "https://localhost:5000/nft/1", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	n1 := nft.NewNFT(nid1, true, sender.Address, "", "https://localhost:5000/nft/2", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+
+	sts = append(sts, t.newStateNFT(n0), t.newStateNFT(n1))
+	sts = append(sts, t.newStateHoldings(sender.Address, t.symbol, []nft.NFTID{nid0, nid1}))
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid0, nid1}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	transfer := t.newTransfer(sender.Address, sender.Privs(), []TransferItem{t.newTransferItem(receiver.Address, nid0, t.cid)})
+	t.NoError(opr.Process(transfer))
+
+	var sbox, rbox NFTBox
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyHoldings(sender.Address, t.symbol):
+			sbox, _ = StateNFTsValue(st.GetState())
+		case StateKeyHoldings(receiver.Address, t.symbol):
+			rbox, _ = StateNFTsValue(st.GetState())
+		}
+	}
+
+	t.False(sbox.Exists(nid0))
+	t.True(sbox.Exists(nid1))
+	t.True(rbox.Exists(nid0))
+	t.Equal(1, len(rbox.NFTs()))
+}
+
+func (t *testTransferOperations) TestLegacyHoldings() {
+	sts := []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(33), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+	receiver, rst := t.newAccount(true, nil)
+
+	sts = append(sts, sst...)
+	sts = append(sts, rst...)
+	sts = append(sts, pst)
+
+	nid0 := nft.NewNFTID(t.symbol, 1)
+	nid1 := nft.NewNFTID(t.symbol, 2)
+	nid2 := nft.NewNFTID(t.symbol, 3)
+	n0 := nft.NewNFT(nid0, true, sender.Address, "", "https://localhost:5000/nft/1", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	n1 := nft.NewNFT(nid1, true, sender.Address, "", "https://localhost:5000/nft/2", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	other := nft.NewTestAddress()
+	n2 := nft.NewNFT(nid2, true, other, "", "https://localhost:5000/nft/3", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+
+	sts = append(sts, t.newStateNFT(n0), t.newStateNFT(n1), t.newStateNFT(n2))
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid0, nid1, nid2}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	legacyValue, _ := state.NewHintedValue(NewNFTBox([]nft.NFTID{nid0, nid1, nid2}))
+	legacyState, err := state.NewStateV0(StateKeyNFTs(t.symbol), legacyValue, base.NilHeight)
+	t.NoError(err)
+	sts = append(sts, legacyState)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	transfer := t.newTransfer(sender.Address, sender.Privs(), []TransferItem{t.newTransferItem(receiver.Address, nid0, t.cid)})
+	t.NoError(opr.Process(transfer))
+
+	var sbox, rbox, obox NFTBox
+	var indexed uint64
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyHoldings(sender.Address, t.symbol):
+			sbox, _ = StateNFTsValue(st.GetState())
+		case StateKeyHoldings(receiver.Address, t.symbol):
+			rbox, _ = StateNFTsValue(st.GetState())
+		case StateKeyHoldings(other, t.symbol):
+			obox, _ = StateNFTsValue(st.GetState())
+		case StateKeyHoldingsIndex(t.symbol):
+			indexed = st.GetState().Value().Interface().(uint64)
+		}
+	}
+
+	t.Equal([]nft.NFTID{nid1}, sbox.NFTs())
+	t.Equal([]nft.NFTID{nid0}, rbox.NFTs())
+	t.Equal([]nft.NFTID{nid2}, obox.NFTs())
+	t.Equal(uint64(3), indexed)
+}
+
+func (t *testTransferOperations) TestLegacyHoldingsIndexed() {
+	sts := []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(33), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+	receiver, rst := t.newAccount(true, nil)
+
+	sts = append(sts, sst...)
+	sts = append(sts, rst...)
+	sts = append(sts, pst)
+
+	nid := nft.NewNFTID(t.symbol, 1)
+	n := nft.NewNFT(nid, true, sender.Address, "", "https://localhost:5000/nft/1", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	sts = append(sts, t.newStateNFT(n))
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	legacyValue, _ := state.NewHintedValue(NewNFTBox([]nft.NFTID{nid}))
+	legacyState, err := state.NewStateV0(StateKeyNFTs(t.symbol), legacyValue, base.NilHeight)
+	t.NoError(err)
+
+	indexValue, _ := state.NewNumberValue(uint64(1))
+	indexState, err := state.NewStateV0(StateKeyHoldingsIndex(t.symbol), indexValue, base.NilHeight)
+	t.NoError(err)
+	sts = append(sts, legacyState, indexState)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	// NOTE the legacy nft box is not loaded again once holdings are indexed.
+	transfer := t.newTransfer(sender.Address, sender.Privs(), []TransferItem{t.newTransferItem(receiver.Address, nid, t.cid)})
+
+	err = opr.Process(transfer)
+	t.Error(err)
+	t.Contains(err.Error(), "nft not found in owner's nft box")
+}
+
+func (t *testTransferOperations) TestNotInHoldings() {
+	sts := []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(33), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+	receiver, rst := t.newAccount(true, nil)
+
+	sts = append(sts, sst...)
+	sts = append(sts, rst...)
+	sts = append(sts, pst)
+
+	nid := nft.NewNFTID(t.symbol, 1)
+	n := nft.NewNFT(nid, true, sender.Address, "", "https://localhost:5000/nft/1", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	sts = append(sts, t.newStateNFT(n), t.newStateHoldings(sender.Address, t.symbol, []nft.NFTID{}))
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	transfer := t.newTransfer(sender.Address, sender.Privs(), []TransferItem{t.newTransferItem(receiver.Address, nid, t.cid)})
+
+	err := opr.Process(transfer)
+	t.Error(err)
+	t.Contains(err.Error(), "nft not found in owner's nft box")
+}
+
+// newNestedNFTs returns the states of sender nfts; the first nft owns the
+// second, and the third is not nested.
+func (t *testTransferOperations) newNestedNFTs() (*account, *account, []nft.NFTID, []state.State) {
+	sts := []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(33), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+	receiver, rst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(33), t.cid)})
+
+	sts = append(sts, sst...)
+	sts = append(sts, rst...)
+	sts = append(sts, pst)
+
+	nids := []nft.NFTID{nft.NewNFTID(t.symbol, 1), nft.NewNFTID(t.symbol, 2), nft.NewNFTID(t.symbol, 3)}
+	na := nft.NewNFTAddress(nids[0])
+
+	n0 := nft.NewNFT(nids[0], true, sender.Address, "", "https://localhost:5000/nft/1", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	n1 := nft.NewNFT(nids[1], true, na, "", "https://localhost:5000/nft/2", na, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	n2 := nft.NewNFT(nids[2], true, sender.Address, "", "https://localhost:5000/nft/3", sender.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+
+	sts = append(sts, t.newStateNFT(n0), t.newStateNFT(n1), t.newStateNFT(n2))
+	sts = append(sts, t.newStateHoldings(sender.Address, t.symbol, []nft.NFTID{nids[0], nids[2]}))
+	sts = append(sts, t.newStateHoldings(na, t.symbol, []nft.NFTID{nids[1]}))
+	sts = append(sts, t.newStateNFTChildren(nids[0], []nft.NFTID{nids[1]}))
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, nids, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	return sender, receiver, nids, sts
+}
+
+func (t *testTransferOperations) TestNestNFT() {
+	sender, _, nids, sts := t.newNestedNFTs()
+	na := nft.NewNFTAddress(nids[0])
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	transfer := t.newTransfer(sender.Address, sender.Privs(), []TransferItem{t.newTransferItem(na, nids[2], t.cid)})
+	t.NoError(opr.Process(transfer))
+
+	var n nft.NFT
+	var sbox, pbox NFTBox
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyNFT(nids[2]):
+			n, _ = StateNFTValue(st.GetState())
+		case StateKeyHoldings(sender.Address, t.symbol):
+			sbox, _ = StateNFTsValue(st.GetState())
+		case StateKeyHoldings(na, t.symbol):
+			pbox, _ = StateNFTsValue(st.GetState())
+		}
+	}
+
+	t.True(n.Owner().Equal(na))
+	t.False(sbox.Exists(nids[2]))
+	t.True(pbox.Exists(nids[1]))
+	t.True(pbox.Exists(nids[2]))
+
+	var children NFTBox
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyNFTChildren(nids[0]) {
+			children, _ = StateNFTsValue(st.GetState())
+		}
+	}
+
+	t.Equal([]nft.NFTID{nids[1], nids[2]}, children.NFTs())
+}
+
+func (t *testTransferOperations) TestNestNFTSubtreeOverMaxDepth() {
+	sender, _, nids, sts := t.newNestedNFTs()
+
+	depth := MaxNFTDepth
+	defer func() {
+		MaxNFTDepth = depth
+	}()
+
+	// nids[0] owns nids[1], so nids[1] is nested 2 levels under nids[2]
+	transfer := t.newTransfer(sender.Address, sender.Privs(), []TransferItem{t.newTransferItem(nft.NewNFTAddress(nids[2]), nids[0], t.cid)})
+
+	MaxNFTDepth = 2
+
+	pool, _ := t.statepool(sts)
+	err := t.processor(nil, pool).Process(transfer)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "nested over max depth")
+
+	MaxNFTDepth = 3
+
+	pool, _ = t.statepool(sts)
+	t.NoError(t.processor(nil, pool).Process(transfer))
+}
+
+func (t *testTransferOperations) TestNestNFTInMovedNFT() {
+	sender, receiver, nids, sts := t.newNestedNFTs()
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	transfer := t.newTransfer(sender.Address, sender.Privs(), []TransferItem{
+		t.newTransferItem(nft.NewNFTAddress(nids[0]), nids[2], t.cid),
+		t.newTransferItem(receiver.Address, nids[0], t.cid),
+	})
+	err := opr.Process(transfer)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "parent nft moved in same transfer")
+}
+
+func (t *testTransferOperations) TestApprovedNestedNFT() {
+	sender, receiver, nids, sts := t.newNestedNFTs()
+	na := nft.NewNFTAddress(nids[0])
+
+	// approval left on nested nft is ignored
+	n1 := nft.NewNFT(nids[1], true, na, "", "https://localhost:5000/nft/2", receiver.Address, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	sts = append(sts, t.newStateNFT(n1))
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	transfer := t.newTransfer(receiver.Address, receiver.Privs(), []TransferItem{t.newTransferItem(receiver.Address, nids[1], t.cid)})
+	err := opr.Process(transfer)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "unauthorized sender")
+
+	pool, _ = t.statepool(sts)
+	opr = t.processor(nil, pool)
+
+	transfer = t.newTransfer(sender.Address, sender.Privs(), []TransferItem{t.newTransferItem(receiver.Address, nids[1], t.cid)})
+	t.NoError(opr.Process(transfer))
+
+	var children NFTBox
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyNFTChildren(nids[0]) {
+			children, _ = StateNFTsValue(st.GetState())
+		}
+	}
+
+	t.True(children.IsEmpty())
+}
+
+func (t *testTransferOperations) TestTransferParentWithChildren() {
+	sender, receiver, nids, sts := t.newNestedNFTs()
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	transfer := t.newTransfer(sender.Address, sender.Privs(), []TransferItem{t.newTransferItem(receiver.Address, nids[0], t.cid)})
+	t.NoError(opr.Process(transfer))
+
+	var updated []state.State
+	for _, st := range pool.Updates() {
+		updated = append(updated, st.GetState())
+	}
+
+	// children follow the new owner of parent
+	pool, _ = t.statepool(sts, updated)
+	opr = t.processor(nil, pool)
+
+	transfer = t.newTransfer(sender.Address, sender.Privs(), []TransferItem{t.newTransferItem(sender.Address, nids[1], t.cid)})
+	err := opr.Process(transfer)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "unauthorized sender")
+
+	pool, _ = t.statepool(sts, updated)
+	opr = t.processor(nil, pool)
+
+	transfer = t.newTransfer(receiver.Address, receiver.Privs(), []TransferItem{t.newTransferItem(receiver.Address, nids[1], t.cid)})
+	t.NoError(opr.Process(transfer))
+
+	var n nft.NFT
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyNFT(nids[1]) {
+			n, _ = StateNFTValue(st.GetState())
+		}
+	}
+
+	t.True(n.Owner().Equal(receiver.Address))
+}
+
+func (t *testTransferOperations) TestNestNFTInItself() {
+	sender, _, nids, sts := t.newNestedNFTs()
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	transfer := t.newTransfer(sender.Address, sender.Privs(), []TransferItem{t.newTransferItem(nft.NewNFTAddress(nids[1]), nids[0], t.cid)})
+	err := opr.Process(transfer)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "nested in itself")
+}
+
+func (t *testTransferOperations) TestNestNFTInUnknownNFT() {
+	sender, _, nids, sts := t.newNestedNFTs()
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	transfer := t.newTransfer(sender.Address, sender.Privs(), []TransferItem{t.newTransferItem(nft.NewNFTAddress(nft.NewNFTID(t.symbol, 10)), nids[2], t.cid)})
+	err := opr.Process(transfer)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "parent nft")
+}
+
+func (t *testTransferOperations) TestContractAccountSender() {
+	sts := []state.State{}
+
+	owner, ost := t.newAccount(true, nil)
+	treasury, _, tst := t.newContractAccount(true, true, owner.Address)
+	parent, _, pst := t.newContractAccount(true, true, owner.Address)
+	receiver, rst := t.newAccount(true, nil)
+
+	sts = append(sts, ost...)
+	sts = append(sts, rst...)
+	sts = append(sts, tst, pst, t.newStateKeys(treasury, extensioncurrency.NewContractAccountKeys()))
+	sts = append(sts, t.newStateAmount(treasury, currency.NewAmount(currency.NewBig(33), t.cid)))
+
+	nid := nft.NewNFTID(t.symbol, 1)
+	n := nft.NewNFT(nid, true, treasury, "", "https://localhost:5000/nft/1", treasury, nft.NewSigners(0, []nft.Signer{}), nft.NewSigners(0, []nft.Signer{}))
+	sts = append(sts, t.newStateNFT(n))
+
+	_, dst := t.newCollectionDesign(true, parent, owner.Address, []base.Address{owner.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
+	sts = append(sts, dst...)
+	sts = append(sts, t.newStateHoldings(treasury, t.symbol, []nft.NFTID{nid}))
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	// signed by receiver, not by the owner of contract account
+	transfer := t.newTransfer(treasury, receiver.Privs(), []TransferItem{t.newTransferItem(receiver.Address, nid, t.cid)})
+	err := opr.Process(transfer)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "invalid signing")
+
+	pool, _ = t.statepool(sts)
+	opr = t.processor(nil, pool)
+
+	transfer = t.newTransfer(treasury, owner.Privs(), []TransferItem{t.newTransferItem(receiver.Address, nid, t.cid)})
+	t.NoError(opr.Process(transfer))
+
+	var un nft.NFT
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyNFT(nid) {
+			un, _ = StateNFTValue(st.GetState())
+		}
+	}
+
+	t.True(un.Owner().Equal(receiver.Address))
+}
+
 func (t *testTransferOperations) TestInsufficientMultipleItemsWithFee() {
 	sts := []state.State{}
 
@@ -582,6 +1014,7 @@ func (t *testTransferOperations) TestSameSenders() {
 
 	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid0, nid1}, []nft.NFTID{})
 	sts = append(sts, dst...)
+	sts = append(sts, t.newStateHoldings(sender.Address, t.symbol, []nft.NFTID{nid0, nid1}))
 
 	pool, _ := t.statepool(sts)
 
@@ -0,0 +1,158 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	TransferShareFactType   = hint.Type("mitum-nft-transfer-share-operation-fact")
+	TransferShareFactHint   = hint.NewHint(TransferShareFactType, "v0.0.1")
+	TransferShareFactHinter = TransferShareFact{BaseHinter: hint.NewBaseHinter(TransferShareFactHint)}
+	TransferShareType       = hint.Type("mitum-nft-transfer-share-operation")
+	TransferShareHint       = hint.NewHint(TransferShareType, "v0.0.1")
+	TransferShareHinter     = TransferShare{BaseOperation: operationHinter(TransferShareHint)}
+)
+
+var MaxTransferShareItems = 10
+
+type TransferShareFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []TransferShareItem
+}
+
+func NewTransferShareFact(token []byte, sender base.Address, items []TransferShareItem) TransferShareFact {
+	fact := TransferShareFact{
+		BaseHinter: hint.NewBaseHinter(TransferShareFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact TransferShareFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact TransferShareFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferShareFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact TransferShareFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return isvalid.InvalidError.Errorf("empty items for TransferShareFact")
+	} else if l > int(MaxTransferShareItems) {
+		return isvalid.InvalidError.Errorf("items over allowed; %d > %d", l, MaxTransferShareItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[nft.NFTID]struct{}{}
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		n := fact.items[i].NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n]; found {
+			return isvalid.InvalidError.Errorf("duplicate nft found; %q", n)
+		}
+
+		founds[n] = struct{}{}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact TransferShareFact) Token() []byte {
+	return fact.token
+}
+
+func (fact TransferShareFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact TransferShareFact) Items() []TransferShareItem {
+	return fact.items
+}
+
+func (fact TransferShareFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{}
+
+	for i := range fact.items {
+		as = append(as, fact.items[i].Addresses()...)
+	}
+
+	as = append(as, fact.sender)
+
+	return as, nil
+}
+
+func (fact TransferShareFact) Rebuild() TransferShareFact {
+	items := make([]TransferShareItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type TransferShare struct {
+	currency.BaseOperation
+}
+
+func NewTransferShare(fact TransferShareFact, fs []base.FactSign, memo string) (TransferShare, error) {
+	bo, err := currency.NewBaseOperationFromFact(TransferShareHint, fact, fs, memo)
+	if err != nil {
+		return TransferShare{}, err
+	}
+
+	return TransferShare{BaseOperation: bo}, nil
+}
@@ -0,0 +1,94 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	TransferShareItemType   = hint.Type("mitum-nft-transfer-share-item")
+	TransferShareItemHint   = hint.NewHint(TransferShareItemType, "v0.0.1")
+	TransferShareItemHinter = TransferShareItem{BaseHinter: hint.NewBaseHinter(TransferShareItemHint)}
+)
+
+type TransferShareItem struct {
+	hint.BaseHinter
+	qualification Qualification
+	nft           nft.NFTID
+	receiver      base.Address
+	share         uint
+	cid           currency.CurrencyID
+}
+
+func NewTransferShareItem(q Qualification, n nft.NFTID, receiver base.Address, share uint, cid currency.CurrencyID) TransferShareItem {
+	return TransferShareItem{
+		BaseHinter:    hint.NewBaseHinter(TransferShareItemHint),
+		qualification: q,
+		nft:           n,
+		receiver:      receiver,
+		share:         share,
+		cid:           cid,
+	}
+}
+
+func (it TransferShareItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.qualification.Bytes(),
+		it.nft.Bytes(),
+		it.receiver.Bytes(),
+		util.UintToBytes(it.share),
+		it.cid.Bytes(),
+	)
+}
+
+func (it TransferShareItem) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, it.BaseHinter, it.qualification, it.nft, it.receiver, it.cid); err != nil {
+		return err
+	}
+
+	if it.qualification.Unsign() {
+		return isvalid.InvalidError.Errorf("invalid qualification for share transfer; %q", it.qualification)
+	}
+
+	if it.share < 1 {
+		return isvalid.InvalidError.Errorf("share must be over zero")
+	}
+
+	if it.share > nft.MaxTotalShare {
+		return isvalid.InvalidError.Errorf("share is over max; %d > %d", it.share, nft.MaxTotalShare)
+	}
+
+	return nil
+}
+
+func (it TransferShareItem) Qualification() Qualification {
+	return it.qualification
+}
+
+func (it TransferShareItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it TransferShareItem) Receiver() base.Address {
+	return it.receiver
+}
+
+func (it TransferShareItem) Share() uint {
+	return it.share
+}
+
+func (it TransferShareItem) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it TransferShareItem) Addresses() []base.Address {
+	return []base.Address{it.receiver}
+}
+
+func (it TransferShareItem) Rebuild() TransferShareItem {
+	return it
+}
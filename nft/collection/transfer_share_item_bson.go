@@ -0,0 +1,38 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (it TransferShareItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"qualification": it.qualification,
+				"nft":           it.nft,
+				"receiver":      it.receiver,
+				"share":         it.share,
+				"currency":      it.cid,
+			}),
+	)
+}
+
+type TransferShareItemBSONUnpacker struct {
+	QU string              `bson:"qualification"`
+	NF bson.Raw            `bson:"nft"`
+	RC base.AddressDecoder `bson:"receiver"`
+	SH uint                `bson:"share"`
+	CR string              `bson:"currency"`
+}
+
+func (it *TransferShareItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uit TransferShareItemBSONUnpacker
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, uit.QU, uit.NF, uit.RC, uit.SH, uit.CR)
+}
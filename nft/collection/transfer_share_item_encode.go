@@ -0,0 +1,41 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *TransferShareItem) unpack(
+	enc encoder.Encoder,
+	q string,
+	bn []byte,
+	brc base.AddressDecoder,
+	share uint,
+	cid string,
+) error {
+
+	it.qualification = Qualification(q)
+
+	if hinter, err := enc.Decode(bn); err != nil {
+		return err
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return util.WrongTypeError.Errorf("not NFTID; %T", hinter)
+	} else {
+		it.nft = n
+	}
+
+	receiver, err := brc.Encode(enc)
+	if err != nil {
+		return err
+	}
+	it.receiver = receiver
+
+	it.share = share
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
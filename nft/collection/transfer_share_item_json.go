@@ -0,0 +1,48 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type TransferShareItemJSONPacker struct {
+	jsonenc.HintedHead
+	QU Qualification       `json:"qualification"`
+	NF nft.NFTID           `json:"nft"`
+	RC base.Address        `json:"receiver"`
+	SH uint                `json:"share"`
+	CR currency.CurrencyID `json:"currency"`
+}
+
+func (it TransferShareItem) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(TransferShareItemJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		QU:         it.qualification,
+		NF:         it.nft,
+		RC:         it.receiver,
+		SH:         it.share,
+		CR:         it.cid,
+	})
+}
+
+type TransferShareItemJSONUnpacker struct {
+	QU string              `json:"qualification"`
+	NF json.RawMessage     `json:"nft"`
+	RC base.AddressDecoder `json:"receiver"`
+	SH uint                `json:"share"`
+	CR string              `json:"currency"`
+}
+
+func (it *TransferShareItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uit TransferShareItemJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, uit.QU, uit.NF, uit.RC, uit.SH, uit.CR)
+}
@@ -0,0 +1,162 @@
+package collection
+
+import (
+	"testing"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testTransferShareItem struct {
+	suite.Suite
+}
+
+func (t *testTransferShareItem) newTransferShare(items []TransferShareItem) (TransferShare, error) {
+	sender := MustAddress(util.UUID().String())
+
+	token := util.UUID().Bytes()
+	fact := NewTransferShareFact(token, sender, items)
+
+	var fs []base.FactSign
+
+	for _, pk := range []key.Privatekey{
+		key.NewBasePrivatekey(),
+		key.NewBasePrivatekey(),
+		key.NewBasePrivatekey(),
+	} {
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return NewTransferShare(fact, fs, "")
+}
+
+func (t *testTransferShareItem) TestNew() {
+	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+	receiver := MustAddress(util.UUID().String())
+
+	op, err := t.newTransferShare([]TransferShareItem{NewTransferShareItem(CreatorQualification, nid, receiver, 10, "MCC")})
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testTransferShareItem) TestUnsignQualification() {
+	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+	receiver := MustAddress(util.UUID().String())
+
+	op, err := t.newTransferShare([]TransferShareItem{NewTransferShareItem(CopyrighterUnsignQualification, nid, receiver, 10, "MCC")})
+	t.NoError(err)
+
+	err = op.IsValid(nil)
+	t.Contains(err.Error(), "invalid qualification")
+}
+
+func (t *testTransferShareItem) TestZeroShare() {
+	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+	receiver := MustAddress(util.UUID().String())
+
+	op, err := t.newTransferShare([]TransferShareItem{NewTransferShareItem(CreatorQualification, nid, receiver, 0, "MCC")})
+	t.NoError(err)
+
+	err = op.IsValid(nil)
+	t.Contains(err.Error(), "share must be over zero")
+}
+
+func (t *testTransferShareItem) TestOverMaxShare() {
+	nid := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+	receiver := MustAddress(util.UUID().String())
+
+	op, err := t.newTransferShare([]TransferShareItem{NewTransferShareItem(CreatorQualification, nid, receiver, nft.MaxTotalShare+1, "MCC")})
+	t.NoError(err)
+
+	err = op.IsValid(nil)
+	t.Contains(err.Error(), "share is over max")
+}
+
+func TestTransferShareItem(t *testing.T) {
+	suite.Run(t, new(testTransferShareItem))
+}
+
+func testTransferShareItemEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		sender := MustAddress(util.UUID().String())
+
+		token := util.UUID().Bytes()
+		nid0 := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+		nid1 := nft.NewNFTID(extensioncurrency.ContractID("ABC"), 2)
+		items := []TransferShareItem{
+			NewTransferShareItem(CreatorQualification, nid0, MustAddress(util.UUID().String()), 10, "MCC"),
+			NewTransferShareItem(CopyrighterQualification, nid1, MustAddress(util.UUID().String()), 20, "MCC"),
+		}
+		fact := NewTransferShareFact(token, sender, items)
+
+		var fs []base.FactSign
+
+		for _, pk := range []key.Privatekey{
+			key.NewBasePrivatekey(),
+			key.NewBasePrivatekey(),
+			key.NewBasePrivatekey(),
+		} {
+			sig, err := base.NewFactSignature(pk, fact, nil)
+			t.NoError(err)
+
+			fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+		}
+
+		op, err := NewTransferShare(fact, fs, "")
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(TransferShare)
+		tb := b.(TransferShare)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(TransferShareFact)
+		ufact := tb.Fact().(TransferShareFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.Equal(len(fact.Items()), len(ufact.Items()))
+
+		for i := range fact.Items() {
+			a := fact.Items()[i]
+			b := ufact.Items()[i]
+
+			t.True(a.NFT().Equal(b.NFT()))
+			t.Equal(a.Qualification(), b.Qualification())
+			t.True(a.Receiver().Equal(b.Receiver()))
+			t.Equal(a.Share(), b.Share())
+			t.Equal(a.Currency(), b.Currency())
+		}
+	}
+
+	return t
+}
+
+func TestTransferShareItemEncodeJSON(t *testing.T) {
+	suite.Run(t, testTransferShareItemEncode(jsonenc.NewEncoder()))
+}
+
+func TestTransferShareItemEncodeBSON(t *testing.T) {
+	suite.Run(t, testTransferShareItemEncode(bsonenc.NewEncoder()))
+}
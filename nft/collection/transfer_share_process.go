@@ -0,0 +1,334 @@
+package collection
+
+import (
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var TransferShareItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(TransferShareItemProcessor)
+	},
+}
+
+var TransferShareProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(TransferShareProcessor)
+	},
+}
+
+func (TransferShare) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type TransferShareItemProcessor struct {
+	cp     *extensioncurrency.CurrencyPool
+	h      valuehash.Hash
+	nft    nft.NFT
+	nst    state.State
+	sender base.Address
+	item   TransferShareItem
+}
+
+func (ipp *TransferShareItemProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) error {
+	if err := ipp.item.IsValid(nil); err != nil {
+		return err
+	}
+
+	nid := ipp.item.NFT()
+
+	// check collection
+	if st, err := existsState(StateKeyCollection(nid.Collection()), "design", getState); err != nil {
+		return err
+	} else if design, err := StateCollectionValue(st); err != nil {
+		return err
+	} else if !design.Active() {
+		return errors.Errorf("deactivated collection; %q", nid.Collection())
+	} else if cst, err := existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "contract account", getState); err != nil {
+		return err
+	} else if ca, err := extensioncurrency.StateContractAccountValue(cst); err != nil {
+		return err
+	} else if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account; %q", design.Parent())
+	}
+
+	// check receiver
+	if err := checkExistsState(currency.StateKeyAccount(ipp.item.Receiver()), getState); err != nil {
+		return err
+	}
+
+	var signers nft.Signers
+	var n nft.NFT
+
+	// check nft
+	if st, err := existsState(StateKeyNFT(nid), "nft", getState); err != nil {
+		return err
+	} else if nv, err := StateNFTValue(st); err != nil {
+		return err
+	} else if !nv.Active() {
+		return errors.Errorf("burned nft; %q", nid)
+	} else {
+		switch ipp.item.Qualification() {
+		case CreatorQualification:
+			signers = nv.Creators()
+		case CopyrighterQualification:
+			signers = nv.Copyrighters()
+		default:
+			return errors.Errorf("wrong qualification; %q", ipp.item.Qualification())
+		}
+		n = nv
+		ipp.nst = st
+	}
+
+	if signers.IndexByAddress(ipp.sender) < 0 {
+		return errors.Errorf("not signer of nft; %q, %q", ipp.sender, nid)
+	}
+
+	// NOTE the pending replacement can not be accepted when the new signer
+	// becomes signer by share.
+	switch st, found, err := getState(StateKeySignerReplacement(nid)); {
+	case err != nil:
+		return err
+	case found:
+		box, err := StateSignerReplacementValue(st)
+		if err != nil {
+			return err
+		}
+
+		if _, found := box.Get(ipp.item.Qualification(), ipp.item.Receiver()); found {
+			return errors.Errorf("receiver has pending signer replacement of nft; %q, %q", ipp.item.Receiver(), nid)
+		}
+	}
+
+	sns := &signers
+	if err := sns.TransferShare(ipp.sender, ipp.item.Receiver(), ipp.item.Share()); err != nil {
+		return err
+	}
+
+	if ipp.item.Qualification() == CreatorQualification {
+		n = nft.NewNFT(n.ID(), n.Active(), n.Owner(), n.NftHash(), n.Uri(), n.Approved(), *sns, n.Copyrighters())
+	} else {
+		n = nft.NewNFT(n.ID(), n.Active(), n.Owner(), n.NftHash(), n.Uri(), n.Approved(), n.Creators(), *sns)
+	}
+
+	if err := n.IsValid(nil); err != nil {
+		return err
+	}
+	ipp.nft = n
+
+	return nil
+}
+
+func (ipp *TransferShareItemProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) ([]state.State, error) {
+
+	var states []state.State
+
+	if st, err := SetStateNFTValue(ipp.nst, ipp.nft); err != nil {
+		return nil, err
+	} else {
+		states = append(states, st)
+	}
+
+	return states, nil
+}
+
+func (ipp *TransferShareItemProcessor) Close() error {
+	ipp.cp = nil
+	ipp.h = nil
+	ipp.nft = nft.NFT{}
+	ipp.nst = nil
+	ipp.sender = nil
+	ipp.item = TransferShareItem{}
+	TransferShareItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type TransferShareProcessor struct {
+	cp *extensioncurrency.CurrencyPool
+	TransferShare
+	ipps         []*TransferShareItemProcessor
+	amountStates map[currency.CurrencyID]currency.AmountState
+	required     map[currency.CurrencyID][2]currency.Big
+}
+
+func NewTransferShareProcessor(cp *extensioncurrency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(TransferShare)
+		if !ok {
+			return nil, errors.Errorf("not TransferShare; %T", op)
+		}
+
+		opp := TransferShareProcessorPool.Get().(*TransferShareProcessor)
+
+		opp.cp = cp
+		opp.TransferShare = i
+		opp.ipps = nil
+		opp.amountStates = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *TransferShareProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact, ok := opp.Fact().(TransferShareFact)
+	if !ok {
+		return nil, operation.NewBaseReasonError("not TransferShareFact; %T", opp.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return nil, operation.NewBaseReasonError(err.Error())
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getState); err != nil {
+		return nil, operation.NewBaseReasonError(err.Error())
+	}
+
+	if err := checkFactSignsByState(fact.Sender(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing; %w", err)
+	}
+
+	ipps := make([]*TransferShareItemProcessor, len(fact.items))
+	for i := range fact.items {
+
+		c := TransferShareItemProcessorPool.Get().(*TransferShareItemProcessor)
+		c.cp = opp.cp
+		c.h = opp.Hash()
+		c.nft = nft.NFT{}
+		c.nst = nil
+		c.sender = fact.Sender()
+		c.item = fact.items[i]
+
+		if err := c.PreProcess(getState, setState); err != nil {
+			return nil, operation.NewBaseReasonError(err.Error())
+		}
+
+		ipps[i] = c
+	}
+
+	opp.ipps = ipps
+
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee; %w", err)
+	} else if sts, err := CheckSenderEnoughBalance(fact.Sender(), required, getState); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee; %w", err)
+	} else {
+		opp.required = required
+		opp.amountStates = sts
+	}
+
+	return opp, nil
+}
+
+func (opp *TransferShareProcessor) Process(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact, ok := opp.Fact().(TransferShareFact)
+	if !ok {
+		return operation.NewBaseReasonError("not TransferShareFact; %T", opp.Fact())
+	}
+
+	var states []state.State
+
+	for i := range opp.ipps {
+		if sts, err := opp.ipps[i].Process(getState, setState); err != nil {
+			return operation.NewBaseReasonError("failed to process transfer share item; %w", err)
+		} else {
+			states = append(states, sts...)
+		}
+	}
+
+	for k := range opp.required {
+		rq := opp.required[k]
+		states = append(states, opp.amountStates[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), states...)
+}
+
+func (opp *TransferShareProcessor) Close() error {
+	for i := range opp.ipps {
+		_ = opp.ipps[i].Close()
+	}
+
+	opp.cp = nil
+	opp.TransferShare = TransferShare{}
+	opp.ipps = nil
+	opp.amountStates = nil
+	opp.required = nil
+
+	TransferShareProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *TransferShareProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact, ok := opp.Fact().(TransferShareFact)
+	if !ok {
+		return nil, errors.Errorf("not TransferShareFact; %T", opp.Fact())
+	}
+
+	items := make([]TransferShareItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateTransferShareItemsFee(opp.cp, items)
+}
+
+func CalculateTransferShareItemsFee(cp *extensioncurrency.CurrencyPool, items []TransferShareItem) (map[currency.CurrencyID][2]currency.Big, error) {
+	required := map[currency.CurrencyID][2]currency.Big{}
+
+	for i := range items {
+		it := items[i]
+
+		rq := [2]currency.Big{currency.ZeroBig, currency.ZeroBig}
+
+		if k, found := required[it.Currency()]; found {
+			rq = k
+		}
+
+		if cp == nil {
+			required[it.Currency()] = [2]currency.Big{rq[0], rq[1]}
+			continue
+		}
+
+		feeer, found := cp.Feeer(it.Currency())
+		if !found {
+			return nil, errors.Errorf("unknown currency id found; %q", it.Currency())
+		}
+		switch k, err := feeer.Fee(currency.ZeroBig); {
+		case err != nil:
+			return nil, err
+		case !k.OverZero():
+			required[it.Currency()] = [2]currency.Big{rq[0], rq[1]}
+		default:
+			required[it.Currency()] = [2]currency.Big{rq[0].Add(k), rq[1].Add(k)}
+		}
+
+	}
+
+	return required, nil
+}
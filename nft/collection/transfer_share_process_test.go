@@ -0,0 +1,224 @@
+package collection
+
+import (
+	"testing"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testTransferShareOperations struct {
+	baseTestOperationProcessor
+	cid    currency.CurrencyID
+	symbol extensioncurrency.ContractID
+}
+
+func (t *testTransferShareOperations) SetupSuite() {
+	t.cid = currency.CurrencyID("SHOWME")
+	t.symbol = extensioncurrency.ContractID("SCOLLECT")
+}
+
+func (t *testTransferShareOperations) processor(cp *extensioncurrency.CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(TransferShareHinter, NewTransferShareProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testTransferShareOperations) newTransferShare(sender base.Address, keys []key.Privatekey, items []TransferShareItem) TransferShare {
+	token := util.UUID().Bytes()
+	fact := NewTransferShareFact(token, sender, items)
+
+	var fs []base.FactSign
+	for _, pk := range keys {
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewTransferShare(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+// newStates returns the states of nft, of which copyrighter is sender with
+// the share.
+func (t *testTransferShareOperations) newStates(share uint) (*account, nft.NFTID, []state.State) {
+	var sts = []state.State{}
+
+	sender, sst := t.newAccount(true, []currency.Amount{currency.NewAmount(currency.NewBig(1000), t.cid)})
+	parent, _, pst := t.newContractAccount(true, true, sender.Address)
+	sts = append(sts, sst...)
+	sts = append(sts, pst)
+
+	nid := nft.NewNFTID(t.symbol, 1)
+	n := nft.NewNFT(
+		nid,
+		true,
+		nft.NewTestAddress(),
+		"",
+		"https://localhost:5000/nft",
+		nft.NewTestAddress(),
+		nft.NewSigners(0, []nft.Signer{}),
+		nft.NewSigners(share, []nft.Signer{nft.NewSigner(sender.Address, share, true, nil, nil)}),
+	)
+	sts = append(sts, t.newStateNFT(n))
+
+	_, dst := t.newCollectionDesign(true, parent, sender.Address, []base.Address{sender.Address}, t.symbol, []nft.NFTID{nid}, []nft.NFTID{})
+	sts = append(sts, dst...)
+
+	return sender, nid, sts
+}
+
+func (t *testTransferShareOperations) processedNFT(pool *storage.Statepool, nid nft.NFTID) nft.NFT {
+	var n nft.NFT
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyNFT(nid) {
+			n, _ = StateNFTValue(st.GetState())
+		}
+	}
+
+	return n
+}
+
+func (t *testTransferShareOperations) TestTransferPart() {
+	receiver, rst := t.newAccount(true, nil)
+
+	sender, nid, sts := t.newStates(30)
+	sts = append(sts, rst...)
+
+	op := t.newTransferShare(sender.Address, sender.Privs(), []TransferShareItem{
+		NewTransferShareItem(CopyrighterQualification, nid, receiver.Address, 10, t.cid),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	t.NoError(opr.Process(op))
+
+	sns := t.processedNFT(pool, nid).Copyrighters()
+	t.Equal(uint(30), sns.Total())
+	t.Equal(2, len(sns.Signers()))
+
+	s := sns.Signers()[sns.IndexByAddress(sender.Address)]
+	t.Equal(uint(20), s.Share())
+	t.True(s.Signed())
+
+	r := sns.Signers()[sns.IndexByAddress(receiver.Address)]
+	t.Equal(uint(10), r.Share())
+	t.False(r.Signed())
+}
+
+func (t *testTransferShareOperations) TestTransferAll() {
+	receiver, rst := t.newAccount(true, nil)
+
+	sender, nid, sts := t.newStates(30)
+	sts = append(sts, rst...)
+
+	op := t.newTransferShare(sender.Address, sender.Privs(), []TransferShareItem{
+		NewTransferShareItem(CopyrighterQualification, nid, receiver.Address, 30, t.cid),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	t.NoError(opr.Process(op))
+
+	sns := t.processedNFT(pool, nid).Copyrighters()
+	t.Equal(uint(30), sns.Total())
+	t.Equal(1, len(sns.Signers()))
+	t.True(sns.IndexByAddress(sender.Address) < 0)
+	t.Equal(uint(30), sns.Signers()[0].Share())
+}
+
+func (t *testTransferShareOperations) TestNotEnoughShare() {
+	receiver, rst := t.newAccount(true, nil)
+
+	sender, nid, sts := t.newStates(30)
+	sts = append(sts, rst...)
+
+	op := t.newTransferShare(sender.Address, sender.Privs(), []TransferShareItem{
+		NewTransferShareItem(CopyrighterQualification, nid, receiver.Address, 31, t.cid),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not enough share")
+}
+
+func (t *testTransferShareOperations) TestNotSigner() {
+	receiver, rst := t.newAccount(true, nil)
+
+	sender, nid, sts := t.newStates(30)
+	sts = append(sts, rst...)
+
+	op := t.newTransferShare(sender.Address, sender.Privs(), []TransferShareItem{
+		NewTransferShareItem(CreatorQualification, nid, receiver.Address, 10, t.cid),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not signer of nft")
+}
+
+func (t *testTransferShareOperations) TestReceiverPendingReplacement() {
+	receiver, rst := t.newAccount(true, nil)
+
+	sender, nid, sts := t.newStates(30)
+	sts = append(sts, rst...)
+
+	box := NewSignerReplacementBox(nid, []SignerReplacement{
+		NewSignerReplacement(CopyrighterQualification, sender.Address, receiver.Address),
+	})
+	value, _ := state.NewHintedValue(box)
+	bst, err := state.NewStateV0(StateKeySignerReplacement(nid), value, base.NilHeight)
+	t.NoError(err)
+	sts = append(sts, bst)
+
+	op := t.newTransferShare(sender.Address, sender.Privs(), []TransferShareItem{
+		NewTransferShareItem(CopyrighterQualification, nid, receiver.Address, 10, t.cid),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := t.processor(nil, pool)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "receiver has pending signer replacement")
+}
+
+func TestTransferShareOperations(t *testing.T) {
+	suite.Run(t, new(testTransferShareOperations))
+}
@@ -0,0 +1,140 @@
+package nft
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	SHA256HashAlgorithm    = HashAlgorithm("sha256")
+	MultihashAlgorithm     = HashAlgorithm("multihash")
+	Keccak256HashAlgorithm = HashAlgorithm("keccak256")
+)
+
+var HashAlgorithmSeparator = ":"
+
+var (
+	cidBase32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+	cidBase32Upper = base32.NewEncoding("ABCDEFGHIJKLMNOPQRSTUVWXYZ234567").WithPadding(base32.NoPadding)
+)
+
+type HashAlgorithm string
+
+func (ha HashAlgorithm) Bytes() []byte {
+	return []byte(ha)
+}
+
+func (ha HashAlgorithm) String() string {
+	return string(ha)
+}
+
+func (ha HashAlgorithm) IsValid([]byte) error {
+	switch ha {
+	case SHA256HashAlgorithm, MultihashAlgorithm, Keccak256HashAlgorithm:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown hash algorithm; %q", ha)
+	}
+}
+
+// CheckDigest checks the payload format of the digest for the algorithm. The
+// digest of multihash is IPFS CID, v0 or v1.
+func (ha HashAlgorithm) CheckDigest(digest string) error {
+	switch ha {
+	case SHA256HashAlgorithm, Keccak256HashAlgorithm:
+		return checkHexDigest(digest, 32)
+	case MultihashAlgorithm:
+		return checkCID(digest)
+	default:
+		return isvalid.InvalidError.Errorf("unknown hash algorithm; %q", ha)
+	}
+}
+
+func checkHexDigest(digest string, size int) error {
+	s := strings.TrimPrefix(digest, "0x")
+
+	if l := len(s); l != size*2 {
+		return errors.Errorf("wrong length of hex digest; %d != %d", l, size*2)
+	}
+
+	if _, err := hex.DecodeString(s); err != nil {
+		return errors.Errorf("not hex digest; %q", digest)
+	}
+
+	return nil
+}
+
+func checkCID(s string) error {
+	if len(s) < 2 {
+		return errors.Errorf("too short cid; %q", s)
+	}
+
+	// NOTE cid v0 is base58btc encoded sha2-256 multihash
+	if len(s) == 46 && strings.HasPrefix(s, "Qm") {
+		b := base58.Decode(s)
+		if len(b) != 34 || b[0] != 0x12 || b[1] != 0x20 {
+			return errors.Errorf("invalid cid v0; %q", s)
+		}
+
+		return nil
+	}
+
+	var b []byte
+	var err error
+	switch s[0] {
+	case 'b':
+		b, err = cidBase32Lower.DecodeString(s[1:])
+	case 'B':
+		b, err = cidBase32Upper.DecodeString(s[1:])
+	case 'z':
+		if b = base58.Decode(s[1:]); len(b) < 1 {
+			err = errors.Errorf("invalid base58btc")
+		}
+	case 'f', 'F':
+		b, err = hex.DecodeString(s[1:])
+	default:
+		return errors.Errorf("unsupported multibase of cid; %q", s[0])
+	}
+
+	if err != nil {
+		return errors.Errorf("invalid multibase encoding of cid; %q", s)
+	}
+
+	return checkCIDv1(b)
+}
+
+func checkCIDv1(b []byte) error {
+	version, n := binary.Uvarint(b)
+	if n <= 0 || version != 1 {
+		return errors.Errorf("invalid cid version; %d", version)
+	}
+	b = b[n:]
+
+	if _, n = binary.Uvarint(b); n <= 0 {
+		return errors.Errorf("invalid cid codec")
+	}
+	b = b[n:]
+
+	if _, n = binary.Uvarint(b); n <= 0 {
+		return errors.Errorf("invalid multihash code")
+	}
+	b = b[n:]
+
+	size, n := binary.Uvarint(b)
+	if n <= 0 {
+		return errors.Errorf("invalid multihash length")
+	}
+	b = b[n:]
+
+	if size < 1 || uint64(len(b)) != size {
+		return errors.Errorf("wrong multihash digest length; %d != %d", len(b), size)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package nft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type testHashAlgorithm struct {
+	suite.Suite
+}
+
+func (t *testHashAlgorithm) TestIsValid() {
+	t.NoError(SHA256HashAlgorithm.IsValid(nil))
+	t.NoError(MultihashAlgorithm.IsValid(nil))
+	t.NoError(Keccak256HashAlgorithm.IsValid(nil))
+	t.Error(HashAlgorithm("md5").IsValid(nil))
+	t.Error(HashAlgorithm("").IsValid(nil))
+}
+
+func (t *testHashAlgorithm) TestHexDigest() {
+	digest := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	t.NoError(SHA256HashAlgorithm.CheckDigest(digest))
+	t.NoError(SHA256HashAlgorithm.CheckDigest("0x" + digest))
+	t.NoError(Keccak256HashAlgorithm.CheckDigest(digest))
+	t.Error(SHA256HashAlgorithm.CheckDigest(digest[:62]))
+	t.Error(SHA256HashAlgorithm.CheckDigest(digest[:62] + "zz"))
+}
+
+func (t *testHashAlgorithm) TestCIDDigest() {
+	t.NoError(MultihashAlgorithm.CheckDigest("QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"))
+	t.NoError(MultihashAlgorithm.CheckDigest("bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"))
+	t.Error(MultihashAlgorithm.CheckDigest("QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbd0"))
+	t.Error(MultihashAlgorithm.CheckDigest("bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbz"))
+	t.Error(MultihashAlgorithm.CheckDigest("xafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"))
+}
+
+func (t *testHashAlgorithm) TestNFTHash() {
+	digest := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	hs := NewNFTHash(SHA256HashAlgorithm, digest)
+	t.NoError(hs.IsValid(nil))
+	t.Equal(SHA256HashAlgorithm, hs.Algorithm())
+	t.Equal(digest, hs.Digest())
+
+	t.Error(NewNFTHash(SHA256HashAlgorithm, "not-hex").IsValid(nil))
+
+	untagged := NFTHash("untagged nft hash")
+	t.NoError(untagged.IsValid(nil))
+	t.Equal(HashAlgorithm(""), untagged.Algorithm())
+}
+
+func TestHashAlgorithm(t *testing.T) {
+	suite.Run(t, new(testHashAlgorithm))
+}
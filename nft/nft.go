@@ -14,6 +14,10 @@ var MaxNFTHashLength = 1024
 
 type NFTHash string
 
+func NewNFTHash(algorithm HashAlgorithm, digest string) NFTHash {
+	return NFTHash(algorithm.String() + HashAlgorithmSeparator + digest)
+}
+
 func (hs NFTHash) Bytes() []byte {
 	return []byte(hs)
 }
@@ -31,9 +35,50 @@ func (hs NFTHash) IsValid([]byte) error {
 		return isvalid.InvalidError.Errorf("nft hash with only spaces")
 	}
 
+	if ha := hs.Algorithm(); ha != "" {
+		if err := ha.CheckDigest(hs.Digest()); err != nil {
+			return isvalid.InvalidError.Errorf("invalid %s nft hash; %w", ha, err)
+		}
+	}
+
 	return nil
 }
 
+// Algorithm returns the tagged hash algorithm, "<algorithm>:<digest>". Empty
+// algorithm is returned for the untagged hash.
+func (hs NFTHash) Algorithm() HashAlgorithm {
+	i := strings.Index(string(hs), HashAlgorithmSeparator)
+	if i < 0 {
+		return ""
+	}
+
+	ha := HashAlgorithm(hs[:i])
+	if err := ha.IsValid(nil); err != nil {
+		return ""
+	}
+
+	return ha
+}
+
+// Normalize returns nft hash to be compared with the others. The hex digest of
+// sha256 and keccak256 is lowercased without 0x prefix; the others are kept.
+func (hs NFTHash) Normalize() NFTHash {
+	switch ha := hs.Algorithm(); ha {
+	case SHA256HashAlgorithm, Keccak256HashAlgorithm:
+		return NewNFTHash(ha, strings.ToLower(strings.TrimPrefix(hs.Digest(), "0x")))
+	default:
+		return hs
+	}
+}
+
+func (hs NFTHash) Digest() string {
+	if hs.Algorithm() == "" {
+		return string(hs)
+	}
+
+	return string(hs)[strings.Index(string(hs), HashAlgorithmSeparator)+1:]
+}
+
 var (
 	NFTType   = hint.Type("mitum-nft-nft")
 	NFTHint   = hint.NewHint(NFTType, "v0.0.1")
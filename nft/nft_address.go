@@ -0,0 +1,50 @@
+package nft
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	NFTAddressType   = hint.Type("mna")
+	NFTAddressHint   = hint.NewHint(NFTAddressType, "v0.0.1")
+	NFTAddressHinter = NFTAddress{StringAddress: base.NewStringAddressWithHint(NFTAddressHint, "")}
+)
+
+// NFTAddress is the address of nft which owns other nfts, "<nft id>mna".
+type NFTAddress struct {
+	base.StringAddress
+}
+
+func NewNFTAddress(id NFTID) NFTAddress {
+	return NFTAddress{StringAddress: base.NewStringAddressWithHint(NFTAddressHint, id.String())}
+}
+
+func (na NFTAddress) IsValid([]byte) error {
+	if err := na.StringAddress.IsValid(nil); err != nil {
+		return isvalid.InvalidError.Errorf("invalid nft address: %w", err)
+	}
+
+	if _, err := na.NFTID(); err != nil {
+		return isvalid.InvalidError.Errorf("invalid nft address: %w", err)
+	}
+
+	return nil
+}
+
+func (na NFTAddress) SetHint(ht hint.Hint) hint.Hinter {
+	na.StringAddress = na.StringAddress.SetHint(ht).(base.StringAddress)
+
+	return na
+}
+
+// NFTID returns the id of nft of the address.
+func (na NFTAddress) NFTID() (NFTID, error) {
+	s := na.String()
+	if len(s) < base.AddressTypeSize {
+		return NFTID{}, isvalid.InvalidError.Errorf("too short nft address; %q", s)
+	}
+
+	return ParseNFTID(s[:len(s)-base.AddressTypeSize])
+}
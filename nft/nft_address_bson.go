@@ -0,0 +1,18 @@
+package nft
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+func (na NFTAddress) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bsontype.String, bsoncore.AppendString(nil, na.String()), nil
+}
+
+func (na *NFTAddress) UnpackBSON(b []byte, _ *bsonenc.Encoder) error {
+	*na = NFTAddress{StringAddress: base.NewStringAddressWithHint(NFTAddressHint, string(b))}
+
+	return nil
+}
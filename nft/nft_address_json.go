@@ -0,0 +1,16 @@
+package nft
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+func (na NFTAddress) MarshalText() ([]byte, error) {
+	return na.Bytes(), nil
+}
+
+func (na *NFTAddress) UnpackJSON(b []byte, _ *jsonenc.Encoder) error {
+	*na = NFTAddress{StringAddress: base.NewStringAddressWithHint(NFTAddressHint, string(b))}
+
+	return nil
+}
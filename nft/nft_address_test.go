@@ -0,0 +1,46 @@
+package nft
+
+import (
+	"testing"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/stretchr/testify/suite"
+)
+
+type testNFTAddress struct {
+	suite.Suite
+}
+
+func (t *testNFTAddress) TestNew() {
+	id := NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+
+	na := NewNFTAddress(id)
+	t.NoError(na.IsValid(nil))
+	t.Equal("ABC-00001mna", na.String())
+
+	nid, err := na.NFTID()
+	t.NoError(err)
+	t.True(nid.Equal(id))
+}
+
+func (t *testNFTAddress) TestInvalidNFTID() {
+	na := NFTAddress{StringAddress: base.NewStringAddressWithHint(NFTAddressHint, "ABC")}
+	t.Error(na.IsValid(nil))
+
+	na = NFTAddress{StringAddress: base.NewStringAddressWithHint(NFTAddressHint, "ABC-0")}
+	t.Error(na.IsValid(nil))
+}
+
+func (t *testNFTAddress) TestEqual() {
+	id := NewNFTID(extensioncurrency.ContractID("ABC"), 1)
+
+	t.True(NewNFTAddress(id).Equal(NewNFTAddress(id)))
+	t.False(NewNFTAddress(id).Equal(NewNFTAddress(NewNFTID(id.Collection(), 2))))
+	t.False(NewNFTAddress(id).Equal(currency.NewAddress(id.String())))
+}
+
+func TestNFTAddress(t *testing.T) {
+	suite.Run(t, new(testNFTAddress))
+}
@@ -3,6 +3,7 @@ package nft
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
 	"github.com/spikeekips/mitum/util"
@@ -11,8 +12,20 @@ import (
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
+// MaxNFTIdx is the default limit of nft idx for the collection whose policy
+// does not set its own limit.
 var MaxNFTIdx uint64 = 10000
 
+// NFTIDPadding is the minimum number of digits of idx in nft id string. It is
+// fixed so the nft id string, which is also used in state keys and digest
+// documents, does not change with the collection limit; ids formatted under
+// the old 10000 cap keep the same string.
+const NFTIDPadding = 5
+
+// MaxNFTIdxLimit is the largest limit a collection policy can set; idx over it
+// does not fit in NFTIDPadding digits, 10^NFTIDPadding-1.
+const MaxNFTIdxLimit uint64 = 99999
+
 var (
 	NFTIDType   = hint.Type("mitum-nft-nft-id")
 	NFTIDHint   = hint.NewHint(NFTIDType, "v0.0.1")
@@ -75,21 +88,10 @@ func (nid NFTID) Equal(cnid NFTID) bool {
 }
 
 func (nid NFTID) String() string {
-	idx := strconv.FormatUint(nid.idx, 10)
-
-	l := len(strconv.FormatUint(uint64(MaxNFTIdx), 10)) - len(idx)
-	for i := 0; i < l; i++ {
-		idx = "0" + idx
-	}
-
-	return fmt.Sprintf("%s-%s", nid.collection, idx)
+	return fmt.Sprintf("%s-%0*d", nid.collection, NFTIDPadding, nid.idx)
 }
 
 func (nid NFTID) IsValid([]byte) error {
-	if nid.idx > uint64(MaxNFTIdx) {
-		return isvalid.InvalidError.Errorf("nid idx over max; %d > %d", nid.idx, MaxNFTIdx)
-	}
-
 	if nid.idx == 0 {
 		return isvalid.InvalidError.Errorf("nid idx must be over zero; %q", nid)
 	}
@@ -100,3 +102,24 @@ func (nid NFTID) IsValid([]byte) error {
 
 	return nil
 }
+
+// ParseNFTID parses nft id string, "<collection>-<idx>". idx may be given with
+// or without zero padding.
+func ParseNFTID(s string) (NFTID, error) {
+	i := strings.LastIndex(s, "-")
+	if i < 1 {
+		return NFTID{}, isvalid.InvalidError.Errorf("invalid nft id; %q", s)
+	}
+
+	idx, err := strconv.ParseUint(s[i+1:], 10, 64)
+	if err != nil {
+		return NFTID{}, isvalid.InvalidError.Wrap(err)
+	}
+
+	id := NewNFTID(extensioncurrency.ContractID(s[:i]), idx)
+	if err := id.IsValid(nil); err != nil {
+		return NFTID{}, err
+	}
+
+	return id, nil
+}
@@ -61,16 +61,37 @@ func (t *testNFTID) TestIDXOverZero() {
 	t.NotNil(nidPositiveIdx.Hash())
 }
 
-func (t *testNFTID) TestIDXOverMax() {
+func (t *testNFTID) TestIDXOverDefaultMax() {
 	nidMaxIdx := NewNFTID(extensioncurrency.ContractID("ABC"), uint64(MaxNFTIdx))
 	t.Equal(uint64(MaxNFTIdx), nidMaxIdx.Idx())
 	t.NoError(nidMaxIdx.IsValid(nil))
 	t.NotNil(nidMaxIdx.Hash())
 
-	if uint64(MaxNFTIdx) < math.MaxUint64 {
-		nidOverMaxIdx := NewNFTID(extensioncurrency.ContractID("ABC"), uint64(MaxNFTIdx)+1)
-		t.Equal(nidOverMaxIdx.Idx(), uint64(MaxNFTIdx)+1)
-		t.Error(nidOverMaxIdx.IsValid(nil))
+	nidOverMaxIdx := NewNFTID(extensioncurrency.ContractID("ABC"), math.MaxUint64)
+	t.Equal(uint64(math.MaxUint64), nidOverMaxIdx.Idx())
+	t.NoError(nidOverMaxIdx.IsValid(nil))
+}
+
+func (t *testNFTID) TestString() {
+	collection := extensioncurrency.ContractID("ABC")
+
+	t.Equal("ABC-00001", NewNFTID(collection, 1).String())
+	t.Equal("ABC-10000", NewNFTID(collection, 10000).String())
+	t.Equal("ABC-123456", NewNFTID(collection, 123456).String())
+}
+
+func (t *testNFTID) TestParse() {
+	nid := NewNFTID(extensioncurrency.ContractID("ABC"), 12)
+
+	for _, s := range []string{"ABC-00012", "ABC-12", nid.String()} {
+		pid, err := ParseNFTID(s)
+		t.NoError(err)
+		t.True(nid.Equal(pid))
+	}
+
+	for _, s := range []string{"ABC", "ABC-", "-12", "ABC-0", "ABC-a1", "AB-1"} {
+		_, err := ParseNFTID(s)
+		t.Error(err)
 	}
 }
 
@@ -1,6 +1,7 @@
 package nft
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/spikeekips/mitum-currency/currency"
@@ -151,6 +152,18 @@ func (t *testNFT) TestNFTHash() {
 	t.NotNil(notTrimmedHashNFT.Hash())
 }
 
+func (t *testNFT) TestNFTHashNormalize() {
+	digest := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	h := NewNFTHash(SHA256HashAlgorithm, digest)
+
+	t.Equal(h, NewNFTHash(SHA256HashAlgorithm, "0x"+strings.ToUpper(digest)).Normalize())
+	t.Equal(NewNFTHash(Keccak256HashAlgorithm, digest), NewNFTHash(Keccak256HashAlgorithm, "0x"+digest).Normalize())
+
+	cid := NewNFTHash(MultihashAlgorithm, "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG")
+	t.Equal(cid, cid.Normalize())
+	t.Equal(NFTHash("Not Tagged"), NFTHash("Not Tagged").Normalize())
+}
+
 func (t *testNFT) TestURI() {
 	noUriNFT := NewNFT(
 		NewTestNFTID(1),
@@ -272,6 +285,7 @@ func (t *testNFTEncode) SetupSuite() {
 	encs.TestAddHinter(SignersHinter)
 	encs.TestAddHinter(NFTIDHinter)
 	encs.TestAddHinter(NFTHinter)
+	encs.TestAddHinter(NFTAddressHinter)
 }
 
 func (t *testNFTEncode) TestMarshal() {
@@ -311,6 +325,38 @@ func (t *testNFTEncode) TestMarshal() {
 	t.Equal(n.Uri(), un.Uri())
 }
 
+func (t *testNFTEncode) TestMarshalNFTOwner() {
+	owner := NewNFTAddress(NewTestNFTID(2))
+	n := NewNFT(
+		NewTestNFTID(1),
+		true,
+		owner,
+		NFTHash(NewTestNFTID(1).Hash().String()),
+		"https://localhost:5000/nft",
+		owner,
+		NewTestSigners(),
+		NewTestSigners(),
+	)
+	t.NoError(n.IsValid(nil))
+
+	b, err := t.enc.Marshal(n)
+	t.NoError(err)
+
+	hinter, err := t.enc.Decode(b)
+	t.NoError(err)
+	un, ok := hinter.(NFT)
+	t.True(ok)
+
+	t.True(n.Equal(un))
+
+	uo, ok := un.Owner().(NFTAddress)
+	t.True(ok)
+
+	pid, err := uo.NFTID()
+	t.NoError(err)
+	t.True(pid.Equal(NewTestNFTID(2)))
+}
+
 func TestNFTEncodeJSON(t *testing.T) {
 	b := new(testNFTEncode)
 	b.enc = jsonenc.NewEncoder()
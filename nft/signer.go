@@ -2,6 +2,7 @@ package nft
 
 import (
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/isvalid"
@@ -9,30 +10,37 @@ import (
 
 var (
 	SignerType   = hint.Type("mitum-nft-signer")
-	SignerHint   = hint.NewHint(SignerType, "v0.0.1")
+	SignerHint   = hint.NewHint(SignerType, "v0.0.2")
 	SignerHinter = Signer{BaseHinter: hint.NewBaseHinter(SignerHint)}
+	// LegacySignerHint is the hint of the signer made before attestation was
+	// introduced; it has no publickey and signature.
+	LegacySignerHint = hint.NewHint(SignerType, "v0.0.1")
 )
 
 var MaxSignerShare uint = 100
 
 type Signer struct {
 	hint.BaseHinter
-	account base.Address
-	share   uint
-	signed  bool
+	account   base.Address
+	share     uint
+	signed    bool
+	publickey key.Publickey
+	signature key.Signature
 }
 
-func NewSigner(account base.Address, share uint, signed bool) Signer {
+func NewSigner(account base.Address, share uint, signed bool, publickey key.Publickey, signature key.Signature) Signer {
 	return Signer{
 		BaseHinter: hint.NewBaseHinter(SignerHint),
 		account:    account,
 		share:      share,
 		signed:     signed,
+		publickey:  publickey,
+		signature:  signature,
 	}
 }
 
-func MustNewSigner(account base.Address, share uint, signed bool) Signer {
-	signer := NewSigner(account, share, signed)
+func MustNewSigner(account base.Address, share uint, signed bool, publickey key.Publickey, signature key.Signature) Signer {
+	signer := NewSigner(account, share, signed, publickey, signature)
 
 	if err := signer.IsValid(nil); err != nil {
 		panic(err)
@@ -49,10 +57,25 @@ func (signer Signer) Bytes() []byte {
 		bs = append(bs, 0)
 	}
 
+	if signer.IsLegacy() {
+		return util.ConcatBytesSlice(
+			signer.account.Bytes(),
+			util.UintToBytes(signer.share),
+			bs,
+		)
+	}
+
+	var pb []byte
+	if signer.publickey != nil {
+		pb = signer.publickey.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		signer.account.Bytes(),
 		util.UintToBytes(signer.share),
 		bs,
+		pb,
+		signer.signature.Bytes(),
 	)
 }
 
@@ -65,6 +88,28 @@ func (signer Signer) IsValid([]byte) error {
 		return isvalid.InvalidError.Errorf("share is over max; %d > %d", signer.share, MaxSignerShare)
 	}
 
+	if signer.IsLegacy() {
+		if signer.publickey != nil || len(signer.signature) > 0 {
+			return isvalid.InvalidError.Errorf("attestation of legacy signer; %q", signer.account)
+		}
+
+		return nil
+	}
+
+	if (signer.publickey == nil) != (len(signer.signature) < 1) {
+		return isvalid.InvalidError.Errorf("publickey and signature of attestation must be given together")
+	}
+
+	if signer.publickey != nil {
+		if !signer.signed {
+			return isvalid.InvalidError.Errorf("attestation of unsigned signer; %q", signer.account)
+		}
+
+		if err := isvalid.Check(nil, false, signer.publickey, signer.signature); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -80,6 +125,33 @@ func (signer Signer) Signed() bool {
 	return signer.signed
 }
 
+// IsLegacy checks whether the signer has the legacy hint without attestation.
+func (signer Signer) IsLegacy() bool {
+	return signer.Hint().Equal(LegacySignerHint)
+}
+
+// Publickey returns the account key which made the attestation.
+func (signer Signer) Publickey() key.Publickey {
+	return signer.publickey
+}
+
+// Signature returns the attestation signature over AttestationBody.
+func (signer Signer) Signature() key.Signature {
+	return signer.signature
+}
+
+// Attested checks whether the signer signed with the attestation. The signer
+// signed before attestation was introduced has no attestation.
+func (signer Signer) Attested() bool {
+	return signer.signed && signer.publickey != nil && len(signer.signature) > 0
+}
+
+// VerifyAttestation verifies the attestation against nft id, nft hash and
+// resolved uri in the network.
+func (signer Signer) VerifyAttestation(networkID base.NetworkID, id NFTID, h NFTHash, uri URI) error {
+	return VerifyAttestation(signer.publickey, signer.signature, networkID, id, h, uri)
+}
+
 func (signer Signer) Equal(csigner Signer) bool {
 	if signer.Share() != csigner.Share() {
 		return false
@@ -93,5 +165,9 @@ func (signer Signer) Equal(csigner Signer) bool {
 		return false
 	}
 
+	if !signer.Signature().Equal(csigner.Signature()) {
+		return false
+	}
+
 	return true
 }
@@ -2,6 +2,7 @@ package nft
 
 import (
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -10,17 +11,21 @@ func (signer Signer) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(bsonenc.MergeBSONM(
 		bsonenc.NewHintedDoc(signer.Hint()),
 		bson.M{
-			"account": signer.account,
-			"share":   signer.share,
-			"signed":  signer.signed,
+			"account":   signer.account,
+			"share":     signer.share,
+			"signed":    signer.signed,
+			"publickey": signer.publickey,
+			"signature": signer.signature,
 		}),
 	)
 }
 
 type SignerBSONUnpacker struct {
-	AC base.AddressDecoder `bson:"account"`
-	SH uint                `bson:"share"`
-	SG bool                `bson:"signed"`
+	AC base.AddressDecoder  `bson:"account"`
+	SH uint                 `bson:"share"`
+	SG bool                 `bson:"signed"`
+	PK key.PublickeyDecoder `bson:"publickey"`
+	SI key.Signature        `bson:"signature"`
 }
 
 func (signer *Signer) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -29,5 +34,5 @@ func (signer *Signer) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return signer.unpack(enc, us.AC, us.SH, us.SG)
+	return signer.unpack(enc, us.AC, us.SH, us.SG, us.PK, us.SI)
 }
@@ -2,6 +2,7 @@ package nft
 
 import (
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/util/encoder"
 )
 
@@ -10,6 +11,8 @@ func (signer *Signer) unpack(
 	ba base.AddressDecoder,
 	share uint,
 	signed bool,
+	bpk key.PublickeyDecoder,
+	sig key.Signature,
 ) error {
 	a, err := ba.Encode(enc)
 	if err != nil {
@@ -20,5 +23,12 @@ func (signer *Signer) unpack(
 	signer.share = share
 	signer.signed = signed
 
+	pk, err := bpk.Encode(enc)
+	if err != nil {
+		return err
+	}
+	signer.publickey = pk
+	signer.signature = sig
+
 	return nil
 }
@@ -2,14 +2,17 @@ package nft
 
 import (
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
 )
 
 type SignerJSONPacker struct {
 	jsonenc.HintedHead
-	AC base.Address `json:"account"`
-	SH uint         `json:"share"`
-	SG bool         `json:"signed"`
+	AC base.Address  `json:"account"`
+	SH uint          `json:"share"`
+	SG bool          `json:"signed"`
+	PK key.Publickey `json:"publickey"`
+	SI key.Signature `json:"signature"`
 }
 
 func (signer Signer) MarshalJSON() ([]byte, error) {
@@ -18,13 +21,17 @@ func (signer Signer) MarshalJSON() ([]byte, error) {
 		AC:         signer.account,
 		SH:         signer.share,
 		SG:         signer.signed,
+		PK:         signer.publickey,
+		SI:         signer.signature,
 	})
 }
 
 type SignerJSONUnpacker struct {
-	AC base.AddressDecoder `json:"account"`
-	SH uint                `json:"share"`
-	SG bool                `json:"signed"`
+	AC base.AddressDecoder  `json:"account"`
+	SH uint                 `json:"share"`
+	SG bool                 `json:"signed"`
+	PK key.PublickeyDecoder `json:"publickey"`
+	SI key.Signature        `json:"signature"`
 }
 
 func (signer *Signer) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -33,5 +40,5 @@ func (signer *Signer) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return signer.unpack(enc, us.AC, us.SH, us.SG)
+	return signer.unpack(enc, us.AC, us.SH, us.SG, us.PK, us.SI)
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/encoder"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
@@ -16,7 +18,7 @@ type testSigner struct {
 }
 
 func (t *testSigner) newSigner(account base.Address, share uint, signed bool) Signer {
-	return MustNewSigner(account, share, signed)
+	return MustNewSigner(account, share, signed, nil, nil)
 }
 
 func (t *testSigner) TestNew() {
@@ -28,19 +30,19 @@ func (t *testSigner) TestNew() {
 }
 
 func (t *testSigner) TestShareOverMax() {
-	signer := NewSigner(NewTestAddress(), MaxSignerShare+1, false)
+	signer := NewSigner(NewTestAddress(), MaxSignerShare+1, false, nil, nil)
 	t.Equal(signer.Share(), MaxSignerShare+1)
 	t.Error(signer.IsValid(nil))
 }
 
 func (t *testSigner) TestShareMax() {
-	signer := NewSigner(NewTestAddress(), MaxSignerShare, false)
+	signer := NewSigner(NewTestAddress(), MaxSignerShare, false, nil, nil)
 	t.Equal(signer.Share(), uint(MaxSignerShare))
 	t.NoError(signer.IsValid(nil))
 }
 
 func (t *testSigner) TestShareZero() {
-	signer := NewSigner(NewTestAddress(), 0, false)
+	signer := NewSigner(NewTestAddress(), 0, false, nil, nil)
 	t.Equal(signer.Share(), uint(0))
 	t.NoError(signer.IsValid(nil))
 }
@@ -72,6 +74,42 @@ func (t *testSigner) TestEqual() {
 	t.False(signer0.Equal(signer4))
 }
 
+func (t *testSigner) TestLegacy() {
+	enc := jsonenc.NewEncoder()
+	encs := encoder.NewEncoders()
+	_ = encs.AddEncoder(enc)
+	_ = encs.TestAddHinter(currency.AddressHinter)
+	_ = encs.TestAddHinter(SignerHinter)
+
+	account := NewTestAddress()
+
+	// NOTE legacy signer has no publickey and signature
+	b, err := jsonenc.Marshal(struct {
+		jsonenc.HintedHead
+		AC base.Address `json:"account"`
+		SH uint         `json:"share"`
+		SG bool         `json:"signed"`
+	}{
+		HintedHead: jsonenc.NewHintedHead(LegacySignerHint),
+		AC:         account,
+		SH:         10,
+		SG:         true,
+	})
+	t.NoError(err)
+
+	hinter, err := enc.Decode(b)
+	t.NoError(err)
+
+	signer, ok := hinter.(Signer)
+	t.True(ok)
+	t.True(signer.IsLegacy())
+	t.NoError(signer.IsValid(nil))
+	t.Equal(util.ConcatBytesSlice(account.Bytes(), util.UintToBytes(10), []byte{1}), signer.Bytes())
+
+	signer.publickey = key.NewBasePrivatekey().Publickey()
+	t.Error(signer.IsValid(nil))
+}
+
 func TestSigner(t *testing.T) {
 	suite.Run(t, new(testSigner))
 }
@@ -90,7 +128,7 @@ func (t *testSignerEncode) SetupSuite() {
 }
 
 func (t *testSignerEncode) TestMarshal() {
-	signer := NewSigner(NewTestAddress(), 50, false)
+	signer := NewSigner(NewTestAddress(), 50, false, nil, nil)
 	t.NoError(signer.IsValid(nil))
 
 	b, err := t.enc.Marshal(signer)
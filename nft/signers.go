@@ -179,3 +179,68 @@ func (signers *Signers) SetSigner(signer Signer) error {
 	signers.signers[idx] = signer
 	return nil
 }
+
+func (signers *Signers) ReplaceSigner(old base.Address, signer Signer) error {
+	idx := signers.IndexByAddress(old)
+	if idx < 0 {
+		return errors.Errorf("signer doesn't exist; %q", old)
+	}
+
+	if i := signers.Index(signer); i >= 0 && i != idx {
+		return errors.Errorf("signer already exists; %q", signer.Account())
+	}
+
+	signers.signers[idx] = signer
+
+	return nil
+}
+
+// TransferShare moves share of signer to receiver. The receiver not in signers
+// is added as unsigned signer and the signer with no share left is removed, so
+// total is not changed.
+func (signers *Signers) TransferShare(from, to base.Address, share uint) error {
+	if from.Equal(to) {
+		return errors.Errorf("receiver is same with signer; %q", from)
+	}
+
+	idx := signers.IndexByAddress(from)
+	if idx < 0 {
+		return errors.Errorf("signer doesn't exist; %q", from)
+	}
+
+	signer := signers.signers[idx]
+	if signer.Share() < share {
+		return errors.Errorf("not enough share; %d < %d", signer.Share(), share)
+	}
+
+	var found bool
+	sns := make([]Signer, 0, len(signers.signers)+1)
+	for i := range signers.signers {
+		s := signers.signers[i]
+
+		switch {
+		case i == idx:
+			if left := s.Share() - share; left > 0 {
+				sns = append(sns, NewSigner(s.Account(), left, s.Signed(), s.Publickey(), s.Signature()))
+			}
+		case s.Account().Equal(to):
+			found = true
+			sns = append(sns, NewSigner(s.Account(), s.Share()+share, s.Signed(), s.Publickey(), s.Signature()))
+		default:
+			sns = append(sns, s)
+		}
+	}
+
+	if !found {
+		sns = append(sns, NewSigner(to, share, false, nil, nil))
+	}
+
+	nsigners := NewSigners(signers.total, sns)
+	if err := nsigners.IsValid(nil); err != nil {
+		return err
+	}
+
+	*signers = nsigners
+
+	return nil
+}
@@ -19,8 +19,8 @@ func (t *testSigners) newSigners(total uint, signers []Signer) Signers {
 }
 
 func (t *testSigners) TestNew() {
-	signer0 := MustNewSigner(NewTestAddress(), 50, false)
-	signer1 := MustNewSigner(NewTestAddress(), 50, false)
+	signer0 := MustNewSigner(NewTestAddress(), 50, false, nil, nil)
+	signer1 := MustNewSigner(NewTestAddress(), 50, false, nil, nil)
 
 	signers := t.newSigners(100, []Signer{signer0, signer1})
 
@@ -35,8 +35,8 @@ func (t *testSigners) TestNew() {
 }
 
 func (t *testSigners) TestTotalOverMax() {
-	signer0 := MustNewSigner(NewTestAddress(), 51, false)
-	signer1 := MustNewSigner(NewTestAddress(), 50, false)
+	signer0 := MustNewSigner(NewTestAddress(), 51, false, nil, nil)
+	signer1 := MustNewSigner(NewTestAddress(), 50, false, nil, nil)
 	signers := NewSigners(uint(MaxTotalShare+1), []Signer{signer0, signer1})
 
 	t.Equal(signers.Total(), uint(MaxTotalShare+1))
@@ -44,8 +44,8 @@ func (t *testSigners) TestTotalOverMax() {
 }
 
 func (t *testSigners) TestTotalMax() {
-	signer0 := MustNewSigner(NewTestAddress(), 20, false)
-	signer1 := MustNewSigner(NewTestAddress(), 80, false)
+	signer0 := MustNewSigner(NewTestAddress(), 20, false, nil, nil)
+	signer1 := MustNewSigner(NewTestAddress(), 80, false, nil, nil)
 	signers := NewSigners(100, []Signer{signer0, signer1})
 
 	t.Equal(signers.Total(), uint(100))
@@ -53,9 +53,9 @@ func (t *testSigners) TestTotalMax() {
 }
 
 func (t *testSigners) TestTotalDifferentShares() {
-	signer0 := MustNewSigner(NewTestAddress(), 40, false)
-	signer1 := MustNewSigner(NewTestAddress(), 40, false)
-	signer2 := MustNewSigner(NewTestAddress(), 40, false)
+	signer0 := MustNewSigner(NewTestAddress(), 40, false, nil, nil)
+	signer1 := MustNewSigner(NewTestAddress(), 40, false, nil, nil)
+	signer2 := MustNewSigner(NewTestAddress(), 40, false, nil, nil)
 
 	signers := NewSigners(120, []Signer{signer0, signer1, signer2})
 	t.Equal(signers.Total(), uint(120))
@@ -63,7 +63,7 @@ func (t *testSigners) TestTotalDifferentShares() {
 }
 
 func (t *testSigners) TestTotalZero() {
-	signer := MustNewSigner(NewTestAddress(), 0, false)
+	signer := MustNewSigner(NewTestAddress(), 0, false, nil, nil)
 	signers := NewSigners(0, []Signer{signer})
 	t.Equal(signers.Total(), uint(0))
 	t.NoError(signers.IsValid(nil))
@@ -72,7 +72,7 @@ func (t *testSigners) TestTotalZero() {
 func (t *testSigners) TestOverMaxSigners() {
 	signers0 := make([]Signer, 10)
 	for i := range signers0 {
-		signers0[i] = MustNewSigner(NewTestAddress(), 10, false)
+		signers0[i] = MustNewSigner(NewTestAddress(), 10, false, nil, nil)
 	}
 	sgns0 := NewSigners(100, signers0)
 
@@ -87,7 +87,7 @@ func (t *testSigners) TestOverMaxSigners() {
 
 	signers1 := make([]Signer, MaxSigners+1)
 	for i := range signers1 {
-		signers1[i] = MustNewSigner(NewTestAddress(), 0, false)
+		signers1[i] = MustNewSigner(NewTestAddress(), 0, false, nil, nil)
 	}
 	sgns1 := NewSigners(0, signers1)
 
@@ -108,8 +108,8 @@ func (t *testSigners) TestZeroSigners() {
 }
 
 func (t *testSigners) TestDuplicateSigner() {
-	signer0 := MustNewSigner(NewTestAddress(), 10, false)
-	signer1 := MustNewSigner(NewTestAddress(), 10, false)
+	signer0 := MustNewSigner(NewTestAddress(), 10, false, nil, nil)
+	signer1 := MustNewSigner(NewTestAddress(), 10, false, nil, nil)
 
 	sgns := NewSigners(30, []Signer{signer0, signer0, signer1})
 
@@ -126,7 +126,7 @@ func (t *testSigners) TestDuplicateSigner() {
 func (t *testSigners) TestEqual() {
 	total := uint(100)
 	signers := []Signer{
-		MustNewSigner(NewTestAddress(), 100, false),
+		MustNewSigner(NewTestAddress(), 100, false, nil, nil),
 	}
 
 	signers0 := t.newSigners(total, signers)
@@ -138,12 +138,68 @@ func (t *testSigners) TestEqual() {
 	t.False(signers0.Equal(signers2))
 
 	signers3 := t.newSigners(total, []Signer{
-		MustNewSigner(NewTestAddress(), 50, false),
-		MustNewSigner(NewTestAddress(), 50, false),
+		MustNewSigner(NewTestAddress(), 50, false, nil, nil),
+		MustNewSigner(NewTestAddress(), 50, false, nil, nil),
 	})
 	t.False(signers0.Equal(signers3))
 }
 
+func (t *testSigners) TestReplaceSigner() {
+	signer0 := MustNewSigner(NewTestAddress(), 40, true, nil, nil)
+	signer1 := MustNewSigner(NewTestAddress(), 60, false, nil, nil)
+	signers := t.newSigners(100, []Signer{signer0, signer1})
+
+	nsigner := MustNewSigner(NewTestAddress(), 40, true, nil, nil)
+	t.NoError(signers.ReplaceSigner(signer0.Account(), nsigner))
+	t.Equal(-1, signers.IndexByAddress(signer0.Account()))
+	t.Equal(0, signers.IndexByAddress(nsigner.Account()))
+	t.NoError(signers.IsValid(nil))
+
+	err := signers.ReplaceSigner(signer0.Account(), MustNewSigner(NewTestAddress(), 40, true, nil, nil))
+	t.Contains(err.Error(), "signer doesn't exist")
+
+	err = signers.ReplaceSigner(nsigner.Account(), MustNewSigner(signer1.Account(), 40, true, nil, nil))
+	t.Contains(err.Error(), "signer already exists")
+}
+
+func (t *testSigners) TestTransferShare() {
+	signer0 := MustNewSigner(NewTestAddress(), 40, true, nil, nil)
+	signer1 := MustNewSigner(NewTestAddress(), 60, false, nil, nil)
+	signers := t.newSigners(100, []Signer{signer0, signer1})
+
+	receiver := NewTestAddress()
+	t.NoError(signers.TransferShare(signer1.Account(), receiver, 15))
+	t.Equal(uint(100), signers.Total())
+	t.Equal(3, len(signers.Signers()))
+	t.Equal(uint(45), signers.Signers()[1].Share())
+	t.Equal(uint(15), signers.Signers()[2].Share())
+	t.False(signers.Signers()[2].Signed())
+
+	t.NoError(signers.TransferShare(signer0.Account(), receiver, 40))
+	t.Equal(uint(100), signers.Total())
+	t.Equal(2, len(signers.Signers()))
+	t.Equal(-1, signers.IndexByAddress(signer0.Account()))
+	t.Equal(uint(55), signers.Signers()[1].Share())
+	t.NoError(signers.IsValid(nil))
+
+	err := signers.TransferShare(receiver, signer1.Account(), 56)
+	t.Contains(err.Error(), "not enough share")
+
+	err = signers.TransferShare(signer0.Account(), receiver, 1)
+	t.Contains(err.Error(), "signer doesn't exist")
+}
+
+func (t *testSigners) TestTransferShareOverMaxSigners() {
+	sns := make([]Signer, MaxSigners)
+	for i := range sns {
+		sns[i] = MustNewSigner(NewTestAddress(), 10, false, nil, nil)
+	}
+	signers := t.newSigners(uint(10*MaxSigners), sns)
+
+	err := signers.TransferShare(sns[0].Account(), NewTestAddress(), 5)
+	t.Contains(err.Error(), "signers over allowed")
+}
+
 func TestSigners(t *testing.T) {
 	suite.Run(t, new(testSigners))
 }
@@ -165,7 +221,7 @@ func (t *testSignersEncode) SetupSuite() {
 func (t *testSignersEncode) TestMarshal() {
 	signers := make([]Signer, 10)
 	for i := range signers {
-		signers[i] = MustNewSigner(NewTestAddress(), 10, false)
+		signers[i] = MustNewSigner(NewTestAddress(), 10, false, nil, nil)
 	}
 	sgns := NewSigners(100, signers)
 	t.NoError(sgns.IsValid(nil))
@@ -125,7 +125,7 @@ func NewTestNFTID(idx uint64) NFTID {
 }
 
 func NewTestSigners() Signers {
-	signer := MustNewSigner(NewTestAddress(), 100, false)
+	signer := MustNewSigner(NewTestAddress(), 100, false, nil, nil)
 	signers := MustNewSigners(100, []Signer{signer})
 	return signers
 }
@@ -0,0 +1,83 @@
+package nft
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	IPFSURIScheme    = URIScheme("ipfs")
+	ArweaveURIScheme = URIScheme("ar")
+	HTTPSURIScheme   = URIScheme("https")
+	HTTPURIScheme    = URIScheme("http")
+)
+
+var (
+	ReValidURIScheme     = regexp.MustCompile(`^[a-z][a-z0-9+\-.]*$`)
+	ReValidArweaveTxID   = regexp.MustCompile(`^[a-zA-Z0-9_\-]{43}$`)
+	MaxLengthURIScheme   = 20
+	MaxAllowedURISchemes = 10
+)
+
+type URIScheme string
+
+func (us URIScheme) Bytes() []byte {
+	return []byte(us)
+}
+
+func (us URIScheme) String() string {
+	return string(us)
+}
+
+func (us URIScheme) IsValid([]byte) error {
+	if l := len(us); l < 1 || l > MaxLengthURIScheme {
+		return isvalid.InvalidError.Errorf("invalid length of uri scheme; 1 <= %d <= %d", l, MaxLengthURIScheme)
+	}
+
+	if !ReValidURIScheme.Match([]byte(us)) {
+		return isvalid.InvalidError.Errorf("wrong uri scheme; %q", us)
+	}
+
+	return nil
+}
+
+// Scheme returns the lower-cased scheme of uri. Empty scheme is returned for
+// the empty or relative uri.
+func (uri URI) Scheme() URIScheme {
+	u, err := url.Parse(string(uri))
+	if err != nil {
+		return ""
+	}
+
+	return URIScheme(strings.ToLower(u.Scheme))
+}
+
+// CheckScheme checks the scheme-specific syntax of uri, like the cid of ipfs
+// uri. The uris of the other schemes are not checked.
+func (uri URI) CheckScheme() error {
+	u, err := url.Parse(string(uri))
+	if err != nil {
+		return err
+	}
+
+	switch URIScheme(strings.ToLower(u.Scheme)) {
+	case IPFSURIScheme:
+		if err := checkCID(u.Host); err != nil {
+			return errors.Wrap(err, "invalid ipfs uri")
+		}
+	case ArweaveURIScheme:
+		if !ReValidArweaveTxID.MatchString(u.Host) {
+			return errors.Errorf("invalid arweave uri; wrong transaction id, %q", u.Host)
+		}
+	case HTTPSURIScheme, HTTPURIScheme:
+		if u.Host == "" {
+			return errors.Errorf("empty host of %s uri", u.Scheme)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package nft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type testURIScheme struct {
+	suite.Suite
+}
+
+func (t *testURIScheme) TestIsValid() {
+	t.NoError(IPFSURIScheme.IsValid(nil))
+	t.NoError(ArweaveURIScheme.IsValid(nil))
+	t.NoError(URIScheme("git+ssh").IsValid(nil))
+	t.Error(URIScheme("").IsValid(nil))
+	t.Error(URIScheme("IPFS").IsValid(nil))
+	t.Error(URIScheme("1pfs").IsValid(nil))
+}
+
+func (t *testURIScheme) TestScheme() {
+	t.Equal(IPFSURIScheme, URI("ipfs://QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG/1.json").Scheme())
+	t.Equal(HTTPSURIScheme, URI("HTTPS://localhost:5000/nft").Scheme())
+	t.Equal(URIScheme(""), URI("").Scheme())
+	t.Equal(URIScheme(""), URI("nft/1.json").Scheme())
+}
+
+func (t *testURIScheme) TestCheckScheme() {
+	t.NoError(URI("ipfs://QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG").CheckScheme())
+	t.NoError(URI("ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi/1.json").CheckScheme())
+	t.Error(URI("ipfs://not-a-cid/1.json").CheckScheme())
+
+	t.NoError(URI("ar://bNbA3TEQVL60xlgCcqdz4ZPHFZ711cZ3hmkpGttDt_U").CheckScheme())
+	t.Error(URI("ar://short").CheckScheme())
+
+	t.NoError(URI("https://localhost:5000/nft").CheckScheme())
+	t.Error(URI("https:///nft").CheckScheme())
+}
+
+func (t *testURIScheme) TestURIWithoutSchemeSyntax() {
+	// NOTE stored uris are not checked by scheme syntax
+	t.NoError(URI("ipfs://not-a-cid/1.json").IsValid(nil))
+	t.NoError(URI("ar://short").IsValid(nil))
+	t.NoError(URI("https:///nft").IsValid(nil))
+}
+
+func TestURIScheme(t *testing.T) {
+	suite.Run(t, new(testURIScheme))
+}
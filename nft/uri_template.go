@@ -0,0 +1,58 @@
+package nft
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	URITemplateIdx        = "{idx}"
+	URITemplateID         = "{id}"
+	URITemplateCollection = "{collection}"
+)
+
+var reURITemplatePlaceholder = regexp.MustCompile(`\{[^{}]*\}`)
+
+// IsTemplate checks whether uri has any template placeholder, "{idx}", "{id}"
+// or "{collection}".
+func (uri URI) IsTemplate() bool {
+	s := string(uri)
+
+	return strings.Contains(s, URITemplateIdx) ||
+		strings.Contains(s, URITemplateID) ||
+		strings.Contains(s, URITemplateCollection)
+}
+
+// CheckTemplate checks every "{...}" placeholder of uri is known one, so
+// unknown placeholders are not stored unresolved in the nft uri.
+func (uri URI) CheckTemplate() error {
+	for _, p := range reURITemplatePlaceholder.FindAllString(string(uri), -1) {
+		switch p {
+		case URITemplateIdx, URITemplateID, URITemplateCollection:
+		default:
+			return isvalid.InvalidError.Errorf("unknown uri template placeholder, %q; %q", p, uri)
+		}
+	}
+
+	return nil
+}
+
+// Resolve fills the template placeholders of uri with the given nft id.
+func (uri URI) Resolve(id NFTID) URI {
+	return uri.resolve(id.Collection().String(), id.Idx(), id.String())
+}
+
+func (uri URI) resolve(collection string, idx uint64, id string) URI {
+	if !uri.IsTemplate() {
+		return uri
+	}
+
+	return URI(strings.NewReplacer(
+		URITemplateIdx, strconv.FormatUint(idx, 10),
+		URITemplateID, id,
+		URITemplateCollection, collection,
+	).Replace(string(uri)))
+}
@@ -0,0 +1,45 @@
+package nft
+
+import (
+	"testing"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/currency"
+	"github.com/stretchr/testify/suite"
+)
+
+type testURITemplate struct {
+	suite.Suite
+}
+
+func (t *testURITemplate) TestIsTemplate() {
+	t.True(URI("https://localhost:5000/nft/{idx}.json").IsTemplate())
+	t.True(URI("https://localhost:5000/{collection}/{id}").IsTemplate())
+	t.False(URI("https://localhost:5000/nft/1.json").IsTemplate())
+	t.False(URI("").IsTemplate())
+}
+
+func (t *testURITemplate) TestResolve() {
+	id := NewNFTID(extensioncurrency.ContractID("ABC"), 7)
+
+	t.Equal(URI("https://localhost:5000/nft/7.json"), URI("https://localhost:5000/nft/{idx}.json").Resolve(id))
+	t.Equal(URI("https://localhost:5000/ABC/"+id.String()), URI("https://localhost:5000/{collection}/{id}").Resolve(id))
+	t.Equal(URI("https://localhost:5000/nft"), URI("https://localhost:5000/nft").Resolve(id))
+}
+
+func (t *testURITemplate) TestIsValid() {
+	t.NoError(URI("ipfs://QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG/{idx}.json").IsValid(nil))
+	t.Error(URI("ipfs://{collection}/{idx}.json").IsValid(nil))
+}
+
+func (t *testURITemplate) TestCheckTemplate() {
+	t.NoError(URI("https://localhost:5000/{collection}/{id}/{idx}.json").CheckTemplate())
+	t.NoError(URI("https://localhost:5000/nft/1.json").CheckTemplate())
+
+	err := URI("https://localhost:5000/{base}/{idx}.json").CheckTemplate()
+	t.Error(err)
+	t.Contains(err.Error(), "{base}")
+}
+
+func TestURITemplate(t *testing.T) {
+	suite.Run(t, new(testURITemplate))
+}